@@ -1,5 +1,7 @@
 package ferrite
 
+import "github.com/dogmatiq/ferrite/internal/variable"
+
 // isBuilderOf makes a static assertion that B has the common methods required
 // for all "builder" types.
 type isBuilderOf[Result, Literal any, Builder interface {
@@ -18,3 +20,39 @@ type isBuilderOfMinimal[Result any, Builder interface {
 	Optional(...OptionalOption) Optional[Result]
 	Deprecated(...DeprecatedOption) Deprecated[Result]
 }] struct{}
+
+// isFinalizedBuilder makes a static assertion that B has a finalize() method.
+//
+// Some parts of a specification depend on more than one of the builder's
+// methods, such as documentation that describes the combination of options in
+// use. Rather than adding these parts as each method is called, builders add
+// them in finalize(), which is called by Required(), Optional() and
+// Deprecated(). This ensures the specification is correct regardless of the
+// order in which the builder's methods are called.
+type isFinalizedBuilder[Builder interface{ finalize() }] struct{}
+
+// userConstraints is a list of user-defined constraints that are added to a
+// spec when the builder is finalized.
+//
+// Builders that add a built-in constraint in finalize() use this type to defer
+// the user-defined constraints until after the built-in constraint has been
+// added, so that the built-in constraint is used as the primary requirement in
+// the documentation.
+type userConstraints[T any] []func(*variable.TypedSpecBuilder[T])
+
+// add adds a user-defined constraint to the list.
+func (c *userConstraints[T]) add(desc string, fn func(T) bool) {
+	*c = append(
+		*c,
+		func(b *variable.TypedSpecBuilder[T]) {
+			b.UserConstraint(desc, fn)
+		},
+	)
+}
+
+// apply adds the constraints to the spec being built by b.
+func (c userConstraints[T]) apply(b *variable.TypedSpecBuilder[T]) {
+	for _, add := range c {
+		add(b)
+	}
+}
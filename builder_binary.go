@@ -65,6 +65,33 @@ func (b *BinaryBuilder[T, B]) WithDefault(v T) *BinaryBuilder[T, B] {
 	return b
 }
 
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *BinaryBuilder[T, B]) WithDefaultFunc(
+	fn func() (v T, ok bool),
+	from ...AnyVariableSet,
+) *BinaryBuilder[T, B] {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *BinaryBuilder[T, B]) WithDefaultIn(p *Profile, v T) *BinaryBuilder[T, B] {
+	b.builder.ProfileDefault(p.profile, v)
+	return b
+}
+
 // WithExample adds an example value to the variable's documentation.
 func (b *BinaryBuilder[T, B]) WithExample(v T, desc string) *BinaryBuilder[T, B] {
 	b.builder.NormativeExample(v, desc)
@@ -2,6 +2,7 @@ package ferrite
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
@@ -40,8 +41,9 @@ func BoolAs[T ~bool](name, desc string) *BoolBuilder[T] {
 
 // BoolBuilder builds a specification for a boolean value.
 type BoolBuilder[T ~bool] struct {
-	schema  variable.TypedSet[T]
-	builder variable.TypedSpecBuilder[T]
+	schema   variable.TypedSet[T]
+	builder  variable.TypedSpecBuilder[T]
+	flexible bool
 }
 
 var _ isBuilderOfMinimal[
@@ -64,6 +66,18 @@ func (b *BoolBuilder[T]) WithLiterals(t, f string) *BoolBuilder[T] {
 	return b
 }
 
+// WithFlexibleLiterals allows the variable to be set to any of a family of
+// commonly used boolean literals, in addition to the literals that represent
+// true and false.
+//
+// The values "true", "yes", "on" and "1" are accepted as true, and "false",
+// "no", "off" and "0" are accepted as false. Literals are matched without
+// regard to case. The canonical literals are unchanged.
+func (b *BoolBuilder[T]) WithFlexibleLiterals() *BoolBuilder[T] {
+	b.flexible = true
+	return b
+}
+
 // WithDefault sets the default value of the variable.
 //
 // It is used when the environment variable is undefined or empty.
@@ -72,20 +86,103 @@ func (b *BoolBuilder[T]) WithDefault(v T) *BoolBuilder[T] {
 	return b
 }
 
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *BoolBuilder[T]) WithDefaultFunc(
+	fn func() (v T, ok bool),
+	from ...AnyVariableSet,
+) *BoolBuilder[T] {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *BoolBuilder[T]) WithDefaultIn(p *Profile, v T) *BoolBuilder[T] {
+	b.builder.ProfileDefault(p.profile, v)
+	return b
+}
+
+// WithNormalizers adds normalizers that transform the variable's value before
+// it is parsed and validated.
+//
+// Normalizers are applied in the order they are given. The original value is
+// still shown in validation output.
+func (b *BoolBuilder[T]) WithNormalizers(n ...Normalizer) *BoolBuilder[T] {
+	addNormalizers(&b.builder, n)
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *BoolBuilder[T]) Required(options ...RequiredOption) Required[T] {
+	b.addFlexibleLiterals()
 	return required(b.schema, &b.builder, options...)
 }
 
 // Optional completes the build process and registers an optional variable with
 // Ferrite's validation system.
 func (b *BoolBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
+	b.addFlexibleLiterals()
 	return optional(b.schema, &b.builder, options...)
 }
 
 // Deprecated completes the build process and registers a deprecated variable
 // with Ferrite's validation system.
 func (b *BoolBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
+	b.addFlexibleLiterals()
 	return deprecated(b.schema, &b.builder, options...)
 }
+
+// addFlexibleLiterals adds the flexible boolean literals to the schema if
+// [BoolBuilder.WithFlexibleLiterals] was called.
+func (b *BoolBuilder[T]) addFlexibleLiterals() {
+	if b.flexible {
+		addFlexibleBoolLiterals(&b.schema, true, false)
+	}
+}
+
+var (
+	flexibleTrueLiterals  = []string{"true", "yes", "on", "1"}
+	flexibleFalseLiterals = []string{"false", "no", "off", "0"}
+)
+
+// addFlexibleBoolLiterals adds the flexible boolean literals to s as aliases of
+// t and f, and enables case-insensitive matching.
+//
+// Any literal that is already used to represent a member of s is skipped.
+func addFlexibleBoolLiterals[T any](s *variable.TypedSet[T], t, f T) {
+	s.CaseInsensitive = true
+
+	add := func(v T, literals []string) {
+	next:
+		for _, lit := range literals {
+			for _, m := range s.Literals() {
+				if strings.EqualFold(m.String, lit) {
+					continue next
+				}
+			}
+
+			s.MemberAliases = append(
+				s.MemberAliases,
+				variable.SetAlias[T]{
+					Value:   v,
+					Literal: variable.Literal{String: lit},
+				},
+			)
+		}
+	}
+
+	add(t, flexibleTrueLiterals)
+	add(f, flexibleFalseLiterals)
+}
@@ -212,6 +212,68 @@ var _ = Describe("type BoolBuilder", func() {
 			})
 		})
 	})
+
+	When("there are normalizers", func() {
+		It("normalizes the value before matching it against the literals", func() {
+			os.Setenv("FERRITE_BOOL", "YES")
+
+			v := builder.
+				WithLiterals("yes", "no").
+				WithNormalizers(ToLower()).
+				Required().
+				Value()
+
+			Expect(v).To(Equal(userDefinedBool(true)))
+		})
+	})
+
+	When("flexible literals are enabled", func() {
+		DescribeTable(
+			"it accepts the flexible literals",
+			func(value string, expect userDefinedBool) {
+				os.Setenv("FERRITE_BOOL", value)
+
+				v := builder.
+					WithFlexibleLiterals().
+					Required().
+					Value()
+
+				Expect(v).To(Equal(expect))
+			},
+			Entry("true", "TRUE", userDefinedBool(true)),
+			Entry("yes", "yes", userDefinedBool(true)),
+			Entry("on", "On", userDefinedBool(true)),
+			Entry("1", "1", userDefinedBool(true)),
+			Entry("false", "False", userDefinedBool(false)),
+			Entry("no", "NO", userDefinedBool(false)),
+			Entry("off", "off", userDefinedBool(false)),
+			Entry("0", "0", userDefinedBool(false)),
+		)
+
+		It("accepts the flexible literals in addition to custom literals", func() {
+			os.Setenv("FERRITE_BOOL", "true")
+
+			v := builder.
+				WithLiterals("enabled", "disabled").
+				WithFlexibleLiterals().
+				Required().
+				Value()
+
+			Expect(v).To(Equal(userDefinedBool(true)))
+		})
+
+		It("does not conflict with custom literals that are part of the family", func() {
+			os.Setenv("FERRITE_BOOL", "Yes")
+
+			v := builder.
+				WithLiterals("yes", "no").
+				WithFlexibleLiterals().
+				Required().
+				Value()
+
+			Expect(v).To(Equal(userDefinedBool(true)))
+		})
+	})
 })
 
 func ExampleBool_required() {
@@ -305,3 +367,28 @@ func ExampleBool_deprecated() {
 	//
 	// value is true
 }
+
+func ExampleBool_flexibleLiterals() {
+	defer example()()
+
+	v := ferrite.
+		Bool("FERRITE_BOOL", "example boolean variable").
+		WithFlexibleLiterals().
+		Deprecated()
+
+	os.Setenv("FERRITE_BOOL", "on")
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_BOOL  example boolean variable  [ true | false ]  ⚠ deprecated variable set to on, equivalent to true
+	//
+	// value is true
+}
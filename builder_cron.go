@@ -0,0 +1,682 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dogmatiq/ferrite/internal/inflect"
+	"github.com/dogmatiq/ferrite/internal/maybe"
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// CronScheduleValue is a schedule described by a cron expression, as produced
+// by a [CronSchedule] variable.
+//
+// The zero value is a schedule that never runs.
+type CronScheduleValue struct {
+	expr string
+
+	// minute, hour, dom, month and dow are bit sets containing the values
+	// that match each field.
+	minute, hour, dom, month, dow uint64
+
+	// domAny and dowAny are true if the day-of-month or day-of-week fields
+	// begin with an asterisk, respectively. When both day fields are
+	// restricted, a day matches if it matches either field, otherwise it must
+	// match both.
+	domAny, dowAny bool
+}
+
+// String returns the canonical representation of the cron expression.
+func (v CronScheduleValue) String() string {
+	return v.expr
+}
+
+// cronSearchYears is the number of years that [CronScheduleValue.Next]
+// searches before concluding that a schedule never runs. It is long enough to
+// find the next February 29th.
+const cronSearchYears = 9
+
+// Next returns the first time after t at which the schedule runs.
+//
+// The schedule is evaluated in t's location. It returns the zero time if the
+// schedule never runs, such as for "0 0 30 2 *" (February 30th).
+func (v CronScheduleValue) Next(t time.Time) time.Time {
+	loc := t.Location()
+
+	next := time.Date(
+		t.Year(), t.Month(), t.Day(),
+		t.Hour(), t.Minute(), 0, 0,
+		loc,
+	).Add(time.Minute)
+
+	limit := next.AddDate(cronSearchYears, 0, 0)
+
+	for next.Before(limit) {
+		if !cronHas(v.month, int(next.Month())) {
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, loc)
+		} else if !v.matchesDay(next) {
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, loc)
+		} else if !cronHas(v.hour, next.Hour()) {
+			next = time.Date(next.Year(), next.Month(), next.Day(), next.Hour()+1, 0, 0, 0, loc)
+		} else if !cronHas(v.minute, next.Minute()) {
+			next = next.Add(time.Minute)
+		} else {
+			return next
+		}
+	}
+
+	return time.Time{}
+}
+
+// matchesDay returns true if the day of t matches the day-of-month and
+// day-of-week fields of the schedule.
+func (v CronScheduleValue) matchesDay(t time.Time) bool {
+	dom := cronHas(v.dom, t.Day())
+	dow := cronHas(v.dow, int(t.Weekday()))
+
+	if v.domAny || v.dowAny {
+		return dom && dow
+	}
+
+	return dom || dow
+}
+
+// CronSchedule configures an environment variable as a schedule described by
+// a cron expression.
+//
+// The value must be a standard 5-field cron expression, such as "*/15 * * * *",
+// or one of the macros "@yearly", "@annually", "@monthly", "@weekly", "@daily",
+// "@midnight" or "@hourly".
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func CronSchedule(name, desc string) *CronScheduleBuilder {
+	b := &CronScheduleBuilder{}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.Documentation().
+		Summary("Cron expression syntax").
+		Paragraph(
+			"Cron expressions consist of 5 fields separated by spaces:",
+			"minute (`0-59`), hour (`0-23`), day-of-month (`1-31`), month (`1-12` or `JAN-DEC`),",
+			"and day-of-week (`0-7` or `SUN-SAT`, where both `0` and `7` are Sunday).",
+		).
+		Format().
+		Paragraph(
+			"Each field may be an asterisk (`*`) to match any value, a single value, a range such as `1-5`,",
+			"or a comma-separated list of values and ranges.",
+			"Any of these may be followed by a step, such as `*/15` or `0-30/10`.",
+			"If both day-of-month and day-of-week are restricted, a day matches if it matches either field.",
+		).
+		Format().
+		Paragraph(
+			"The macros `@yearly` (or `@annually`), `@monthly`, `@weekly`, `@daily` (or `@midnight`)",
+			"and `@hourly` may be used in place of the 5 fields.",
+		).
+		Format().
+		Done()
+
+	return b
+}
+
+// CronScheduleBuilder builds a specification for a cron schedule variable.
+type CronScheduleBuilder struct {
+	schema   cronScheduleSchema
+	builder  variable.TypedSpecBuilder[CronScheduleValue]
+	def      maybe.Value[CronScheduleValue]
+	examples []variable.TypedExample[CronScheduleValue]
+}
+
+var _ isBuilderOf[
+	CronScheduleValue,
+	string,
+	*CronScheduleBuilder,
+]
+
+// WithDefault sets the default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+//
+// The generated documentation includes a human-readable description of the
+// default schedule.
+func (b *CronScheduleBuilder) WithDefault(v string) *CronScheduleBuilder {
+	b.def = maybe.Some(mustParseCronSchedule(v))
+	return b
+}
+
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *CronScheduleBuilder) WithDefaultFunc(
+	fn func() (v CronScheduleValue, ok bool),
+	from ...AnyVariableSet,
+) *CronScheduleBuilder {
+	b.def = maybe.None[CronScheduleValue]()
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *CronScheduleBuilder) WithDefaultIn(p *Profile, v string) *CronScheduleBuilder {
+	b.builder.ProfileDefault(p.profile, mustParseCronSchedule(v))
+	return b
+}
+
+// WithExample adds an example value to the variable's documentation.
+func (b *CronScheduleBuilder) WithExample(v string, desc string) *CronScheduleBuilder {
+	b.examples = append(b.examples, variable.TypedExample[CronScheduleValue]{
+		Native:      mustParseCronSchedule(v),
+		Description: desc,
+	})
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *CronScheduleBuilder) Required(options ...RequiredOption) Required[CronScheduleValue] {
+	b.finalize()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *CronScheduleBuilder) Optional(options ...OptionalOption) Optional[CronScheduleValue] {
+	b.finalize()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *CronScheduleBuilder) Deprecated(options ...DeprecatedOption) Deprecated[CronScheduleValue] {
+	b.finalize()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+// finalize adds the default value and examples to the specification, such that
+// the default value is always the first example, regardless of the order in
+// which the builder methods are called.
+func (b *CronScheduleBuilder) finalize() {
+	if v, ok := b.def.Get(); ok {
+		b.builder.Default(v)
+		b.builder.NormativeExample(v, v.describe())
+	}
+
+	for _, eg := range b.examples {
+		b.builder.NormativeExample(eg.Native, eg.Description)
+	}
+}
+
+// cronScheduleSchema is the schema for a [CronSchedule] variable.
+type cronScheduleSchema struct{}
+
+func (s cronScheduleSchema) Type() reflect.Type {
+	return reflectx.TypeOf[CronScheduleValue]()
+}
+
+func (s cronScheduleSchema) Finalize() error {
+	return nil
+}
+
+func (s cronScheduleSchema) AcceptVisitor(v variable.SchemaVisitor) {
+	v.VisitOther(s)
+}
+
+func (s cronScheduleSchema) Marshal(v CronScheduleValue) (variable.Literal, error) {
+	if v.expr == "" {
+		return variable.Literal{}, errors.New("expected a cron expression")
+	}
+	return variable.Literal{String: v.expr}, nil
+}
+
+func (s cronScheduleSchema) Unmarshal(v variable.Literal) (CronScheduleValue, error) {
+	return parseCronSchedule(v.String)
+}
+
+func (s cronScheduleSchema) Examples(conservative bool) []variable.TypedExample[CronScheduleValue] {
+	exprs := []string{"*/15 * * * *", "0 2 * * *", "30 9 * * MON-FRI", "@hourly"}
+	if conservative {
+		exprs = exprs[:1]
+	}
+
+	var examples []variable.TypedExample[CronScheduleValue]
+	for _, expr := range exprs {
+		v := mustParseCronSchedule(expr)
+		examples = append(examples, variable.TypedExample[CronScheduleValue]{
+			Native:      v,
+			Description: v.describe(),
+		})
+	}
+
+	return examples
+}
+
+// cronMacros is a map of cron macros to their equivalent expressions.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronField describes one of the fields of a cron expression.
+type cronField struct {
+	Name     string
+	Min, Max int
+
+	// Names is the list of names that may be used in place of the numeric
+	// values, starting at Min.
+	Names []string
+}
+
+var (
+	cronMinute = cronField{Name: "minute", Min: 0, Max: 59}
+	cronHour   = cronField{Name: "hour", Min: 0, Max: 23}
+	cronDOM    = cronField{Name: "day-of-month", Min: 1, Max: 31}
+	cronMonth  = cronField{
+		Name: "month", Min: 1, Max: 12,
+		Names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"},
+	}
+	cronDOW = cronField{
+		Name: "day-of-week", Min: 0, Max: 7,
+		Names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"},
+	}
+)
+
+// parseCronSchedule parses a cron expression or macro.
+func parseCronSchedule(s string) (CronScheduleValue, error) {
+	var v CronScheduleValue
+
+	if strings.HasPrefix(s, "@") {
+		macro := strings.ToLower(strings.TrimSpace(s))
+		expr, ok := cronMacros[macro]
+		if !ok {
+			return v, fmt.Errorf(
+				"%s is not a recognized macro, expected @yearly, @annually, @monthly, @weekly, @daily, @midnight or @hourly",
+				variable.Literal{String: s}.Quote(),
+			)
+		}
+
+		v, err := parseCronSchedule(expr)
+		v.expr = macro
+		return v, err
+	}
+
+	fields := strings.Fields(s)
+	if len(fields) != 5 {
+		return v, fmt.Errorf(
+			"expected 5 fields (minute, hour, day-of-month, month and day-of-week), got %d",
+			len(fields),
+		)
+	}
+
+	var err error
+
+	if v.minute, _, err = cronMinute.parse(fields[0]); err != nil {
+		return v, err
+	}
+	if v.hour, _, err = cronHour.parse(fields[1]); err != nil {
+		return v, err
+	}
+	if v.dom, v.domAny, err = cronDOM.parse(fields[2]); err != nil {
+		return v, err
+	}
+	if v.month, _, err = cronMonth.parse(fields[3]); err != nil {
+		return v, err
+	}
+	if v.dow, v.dowAny, err = cronDOW.parse(fields[4]); err != nil {
+		return v, err
+	}
+
+	// Both 0 and 7 represent Sunday.
+	if cronHas(v.dow, 7) {
+		v.dow = v.dow&^(1<<7) | 1
+	}
+
+	v.expr = strings.ToUpper(strings.Join(fields, " "))
+
+	return v, nil
+}
+
+// parse parses the field's portion of a cron expression, returning the set of
+// matching values, and whether the field begins with an asterisk.
+func (f cronField) parse(s string) (set uint64, any bool, err error) {
+	for _, item := range strings.Split(s, ",") {
+		bits, err := f.parseItem(item)
+		if err != nil {
+			return 0, false, fmt.Errorf(
+				"the %s field (%s) is invalid: %w",
+				f.Name,
+				variable.Literal{String: s}.Quote(),
+				err,
+			)
+		}
+		set |= bits
+	}
+
+	return set, strings.HasPrefix(s, "*"), nil
+}
+
+// parseItem parses a single element of a comma-separated list within a field.
+func (f cronField) parseItem(s string) (uint64, error) {
+	if s == "" {
+		return 0, errors.New("the list contains an empty element")
+	}
+
+	r, stepText, hasStep := strings.Cut(s, "/")
+
+	step := 1
+	if hasStep {
+		n, err := strconv.Atoi(stepText)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf(
+				"the step (%s) must be a positive integer",
+				variable.Literal{String: stepText}.Quote(),
+			)
+		}
+		step = n
+	}
+
+	var low, high int
+
+	if r == "*" {
+		low, high = f.Min, f.Max
+	} else if lowText, highText, ok := strings.Cut(r, "-"); ok {
+		var err error
+
+		if low, err = f.parseValue(lowText); err != nil {
+			return 0, err
+		}
+		if high, err = f.parseValue(highText); err != nil {
+			return 0, err
+		}
+
+		if low > high {
+			return 0, fmt.Errorf(
+				"the start of the range (%s) is greater than its end (%s)",
+				variable.Literal{String: lowText}.Quote(),
+				variable.Literal{String: highText}.Quote(),
+			)
+		}
+	} else {
+		var err error
+		if low, err = f.parseValue(r); err != nil {
+			return 0, err
+		}
+
+		// A single value with a step, such as "5/15", is shorthand for a range
+		// that ends at the field's maximum value.
+		high = low
+		if hasStep {
+			high = f.Max
+		}
+	}
+
+	var set uint64
+	for i := low; i <= high; i += step {
+		set |= 1 << i
+	}
+
+	return set, nil
+}
+
+// parseValue parses a single numeric or named value within a field.
+func (f cronField) parseValue(s string) (int, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		if n < f.Min || n > f.Max {
+			return 0, fmt.Errorf(
+				"%d is out of range, expected a value between %d and %d",
+				n,
+				f.Min,
+				f.Max,
+			)
+		}
+		return n, nil
+	}
+
+	for i, name := range f.Names {
+		if strings.EqualFold(s, name) {
+			return f.Min + i, nil
+		}
+	}
+
+	if len(f.Names) != 0 {
+		return 0, fmt.Errorf(
+			"%s is not a valid %s, expected a value between %d and %d, or a name such as %s",
+			variable.Literal{String: s}.Quote(),
+			f.Name,
+			f.Min,
+			f.Max,
+			f.Names[0],
+		)
+	}
+
+	return 0, fmt.Errorf(
+		"%s is not a valid %s, expected a value between %d and %d",
+		variable.Literal{String: s}.Quote(),
+		f.Name,
+		f.Min,
+		f.Max,
+	)
+}
+
+// cronHas returns true if the bit set s contains n.
+func cronHas(s uint64, n int) bool {
+	return s&(1<<n) != 0
+}
+
+func mustParseCronSchedule(v string) CronScheduleValue {
+	s, err := parseCronSchedule(v)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// describe returns a human-readable description of the schedule, such as
+// "at 02:30 every day".
+func (v CronScheduleValue) describe() string {
+	var w strings.Builder
+
+	minutes := cronValues(v.minute, cronMinute)
+	hours := cronValues(v.hour, cronHour)
+	allMinutes := len(minutes) == 60
+	allHours := len(hours) == 24
+	clock := len(minutes) == 1 && !allHours && len(hours) <= 4
+
+	if clock {
+		var times []string
+		for _, h := range hours {
+			times = append(times, fmt.Sprintf("%02d:%02d", h, minutes[0]))
+		}
+		w.WriteString("at " + inflect.AndList(times))
+	} else {
+		switch step := cronStep(minutes, cronMinute); {
+		case allMinutes:
+			w.WriteString("every minute")
+		case step != 0:
+			fmt.Fprintf(&w, "every %d minutes", step)
+		default:
+			fmt.Fprintf(
+				&w,
+				"at %s %s",
+				inflect.Pluralize("minute", len(minutes)),
+				cronRanges(minutes, strconv.Itoa),
+			)
+		}
+
+		if !allHours || (!allMinutes && cronStep(minutes, cronMinute) == 0) {
+			if allMinutes || cronStep(minutes, cronMinute) != 0 {
+				w.WriteString(" during ")
+			} else {
+				w.WriteString(" of ")
+			}
+
+			switch step := cronStep(hours, cronHour); {
+			case allHours:
+				w.WriteString("every hour")
+			case step != 0:
+				fmt.Fprintf(&w, "every %s hour", ordinal(step))
+			default:
+				fmt.Fprintf(
+					&w,
+					"%s %s",
+					inflect.Pluralize("hour", len(hours)),
+					cronRanges(hours, strconv.Itoa),
+				)
+			}
+		}
+	}
+
+	days := cronValues(v.dom, cronDOM)
+	weekdays := cronValues(v.dow, cronDOW)
+	allDays := len(days) == 31
+	allWeekdays := len(weekdays) == 7
+
+	dayNames := func(n int) string {
+		return time.Weekday(n).String()
+	}
+
+	months := cronValues(v.month, cronMonth)
+	allMonths := len(months) == 12
+
+	monthName := func(n int) string {
+		return time.Month(n).String()
+	}
+
+	dayOfMonth := func() string {
+		if step := cronStep(days, cronDOM); step != 0 {
+			return fmt.Sprintf(" on every %s day of the month", ordinal(step))
+		}
+		return fmt.Sprintf(
+			" on %s %s of the month",
+			inflect.Pluralize("day", len(days)),
+			cronRanges(days, strconv.Itoa),
+		)
+	}
+
+	switch {
+	case allDays && allWeekdays:
+		if clock {
+			w.WriteString(" every day")
+		}
+	case allWeekdays && len(days) == 1 && len(months) == 1:
+		fmt.Fprintf(&w, " on %s %d", monthName(months[0]), days[0])
+		return w.String()
+	case allWeekdays:
+		w.WriteString(dayOfMonth())
+	case allDays:
+		w.WriteString(" on " + cronRanges(weekdays, dayNames))
+	default:
+		w.WriteString(dayOfMonth())
+		if v.domAny || v.dowAny {
+			w.WriteString(" if it is also ")
+		} else {
+			w.WriteString(" or on ")
+		}
+		w.WriteString(cronRanges(weekdays, dayNames))
+	}
+
+	if !allMonths {
+		w.WriteString(" in " + cronRanges(months, monthName))
+	}
+
+	return w.String()
+}
+
+// cronValues returns the values in the bit set s, in ascending order.
+func cronValues(s uint64, f cronField) []int {
+	values := make([]int, 0, bits.OnesCount64(s))
+	for i := f.Min; i <= f.Max; i++ {
+		if cronHas(s, i) {
+			values = append(values, i)
+		}
+	}
+	return values
+}
+
+// cronStep returns the step between the values if they are exactly the values
+// produced by "*/step" for the given field, otherwise it returns 0.
+func cronStep(values []int, f cronField) int {
+	if len(values) < 2 || values[0] != f.Min {
+		return 0
+	}
+
+	step := values[1] - values[0]
+	if step == 1 {
+		return 0
+	}
+
+	for i := 2; i < len(values); i++ {
+		if values[i]-values[i-1] != step {
+			return 0
+		}
+	}
+
+	if values[len(values)-1]+step <= f.Max {
+		return 0
+	}
+
+	return step
+}
+
+// cronRanges returns a human-readable list of values, in which runs of three
+// or more consecutive values are collapsed into a range.
+func cronRanges(values []int, format func(int) string) string {
+	var items []string
+
+	for i := 0; i < len(values); {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+
+		if j-i >= 2 {
+			items = append(items, format(values[i])+" through "+format(values[j]))
+		} else {
+			for k := i; k <= j; k++ {
+				items = append(items, format(values[k]))
+			}
+		}
+
+		i = j + 1
+	}
+
+	return inflect.AndList(items)
+}
+
+// ordinal returns the ordinal form of n, such as "2nd" or "11th".
+func ordinal(n int) string {
+	suffix := "th"
+	if n%100 < 11 || n%100 > 13 {
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return strconv.Itoa(n) + suffix
+}
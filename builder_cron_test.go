@@ -0,0 +1,332 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type CronScheduleBuilder", func() {
+	var builder *CronScheduleBuilder
+
+	BeforeEach(func() {
+		builder = CronSchedule("FERRITE_CRON", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			CronSchedule("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			CronSchedule("FERRITE_CRON", "").Optional()
+		}).To(PanicWith("specification for FERRITE_CRON is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the default value is invalid", func() {
+		Expect(func() {
+			builder.WithDefault("* * *")
+		}).To(PanicWith(MatchError(
+			"expected 5 fields (minute, hour, day-of-month, month and day-of-week), got 3",
+		)))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the schedule",
+					func(value, canonical string) {
+						os.Setenv("FERRITE_CRON", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v.String()).To(Equal(canonical))
+					},
+					Entry("every minute", "* * * * *", "* * * * *"),
+					Entry("step", "*/15 * * * *", "*/15 * * * *"),
+					Entry("lists and ranges", "0,30 9-17 * * 1-5", "0,30 9-17 * * 1-5"),
+					Entry("names", "0 0 * jan,jul sun", "0 0 * JAN,JUL SUN"),
+					Entry("extra whitespace", " 0  2 * *\t* ", "0 2 * * *"),
+					Entry("macro", "@daily", "@daily"),
+					Entry("uppercase macro", "@HOURLY", "@hourly"),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_CRON", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"wrong number of fields",
+						"0 2 * *",
+						`value of FERRITE_CRON ('0 2 * *') is invalid: expected 5 fields (minute, hour, day-of-month, month and day-of-week), got 4`,
+					),
+					Entry(
+						"minute out of range",
+						"60 * * * *",
+						`value of FERRITE_CRON ('60 * * * *') is invalid: the minute field (60) is invalid: 60 is out of range, expected a value between 0 and 59`,
+					),
+					Entry(
+						"hour out of range",
+						"0 24 * * *",
+						`value of FERRITE_CRON ('0 24 * * *') is invalid: the hour field (24) is invalid: 24 is out of range, expected a value between 0 and 23`,
+					),
+					Entry(
+						"day-of-month out of range",
+						"0 0 0 * *",
+						`value of FERRITE_CRON ('0 0 0 * *') is invalid: the day-of-month field (0) is invalid: 0 is out of range, expected a value between 1 and 31`,
+					),
+					Entry(
+						"unknown month name",
+						"0 0 * FOO *",
+						`value of FERRITE_CRON ('0 0 * FOO *') is invalid: the month field (FOO) is invalid: FOO is not a valid month, expected a value between 1 and 12, or a name such as JAN`,
+					),
+					Entry(
+						"day-of-week out of range",
+						"0 0 * * 8",
+						`value of FERRITE_CRON ('0 0 * * 8') is invalid: the day-of-week field (8) is invalid: 8 is out of range, expected a value between 0 and 7`,
+					),
+					Entry(
+						"backwards range",
+						"0 17-9 * * *",
+						`value of FERRITE_CRON ('0 17-9 * * *') is invalid: the hour field (17-9) is invalid: the start of the range (17) is greater than its end (9)`,
+					),
+					Entry(
+						"zero step",
+						"*/0 * * * *",
+						`value of FERRITE_CRON ('*/0 * * * *') is invalid: the minute field ('*/0') is invalid: the step (0) must be a positive integer`,
+					),
+					Entry(
+						"empty list element",
+						"0,,30 * * * *",
+						`value of FERRITE_CRON ('0,,30 * * * *') is invalid: the minute field (0,,30) is invalid: the list contains an empty element`,
+					),
+					Entry(
+						"unknown macro",
+						"@reboot",
+						`value of FERRITE_CRON (@reboot) is invalid: @reboot is not a recognized macro, expected @yearly, @annually, @monthly, @weekly, @daily, @midnight or @hourly`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("@daily").
+							Required().
+							Value()
+
+						Expect(v.String()).To(Equal("@daily"))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_CRON is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+})
+
+var _ = Describe("type CronScheduleValue", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	Describe("func Next()", func() {
+		// Monday, 19 October 2026.
+		from := time.Date(2026, 10, 19, 8, 5, 30, 0, time.UTC)
+
+		DescribeTable(
+			"it returns the next time the schedule runs",
+			func(expr string, expect time.Time) {
+				os.Setenv("FERRITE_CRON", expr)
+
+				v := CronSchedule("FERRITE_CRON", "<desc>").
+					Required().
+					Value()
+
+				Expect(v.Next(from)).To(Equal(expect))
+			},
+			Entry("every minute", "* * * * *", time.Date(2026, 10, 19, 8, 6, 0, 0, time.UTC)),
+			Entry("step", "*/15 * * * *", time.Date(2026, 10, 19, 8, 15, 0, 0, time.UTC)),
+			Entry("later today", "30 9 * * *", time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)),
+			Entry("tomorrow", "0 2 * * *", time.Date(2026, 10, 20, 2, 0, 0, 0, time.UTC)),
+			Entry("day-of-week", "0 0 * * FRI", time.Date(2026, 10, 23, 0, 0, 0, 0, time.UTC)),
+			Entry("sunday as 7", "0 0 * * 7", time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC)),
+			Entry("day-of-month", "0 0 1 * *", time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)),
+			Entry("day-of-month or day-of-week", "0 0 1 * WED", time.Date(2026, 10, 21, 0, 0, 0, 0, time.UTC)),
+			Entry("day-of-month and day-of-week with asterisk", "0 0 */2 * MON", time.Date(2026, 11, 9, 0, 0, 0, 0, time.UTC)),
+			Entry("next year", "@yearly", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)),
+			Entry("leap day", "0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)),
+			Entry("never", "0 0 30 2 *", time.Time{}),
+		)
+
+		It("evaluates the schedule in the location of the given time", func() {
+			loc := time.FixedZone("UTC+10", 10*60*60)
+
+			os.Setenv("FERRITE_CRON", "0 2 * * *")
+
+			v := CronSchedule("FERRITE_CRON", "<desc>").
+				Required().
+				Value()
+
+			Expect(v.Next(from.In(loc))).To(Equal(time.Date(2026, 10, 20, 2, 0, 0, 0, loc)))
+		})
+
+		It("never runs if the schedule is the zero value", func() {
+			var v CronScheduleValue
+			Expect(v.Next(from)).To(Equal(time.Time{}))
+		})
+	})
+})
+
+func ExampleCronSchedule_required() {
+	defer example()()
+
+	v := ferrite.
+		CronSchedule("FERRITE_CRON", "example cron schedule variable").
+		Required()
+
+	os.Setenv("FERRITE_CRON", "30 9 * * mon-fri")
+	ferrite.Init()
+
+	from := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC) // a Saturday
+
+	fmt.Println("value is", v.Value())
+	fmt.Println("next run is", v.Value().Next(from).Format(time.RFC1123))
+
+	// Output:
+	// value is 30 9 * * MON-FRI
+	// next run is Mon, 19 Oct 2026 09:30:00 UTC
+}
+
+func ExampleCronSchedule_default() {
+	defer example()()
+
+	v := ferrite.
+		CronSchedule("FERRITE_CRON", "example cron schedule variable").
+		WithDefault("@hourly").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is @hourly
+}
+
+func ExampleCronSchedule_optional() {
+	defer example()()
+
+	v := ferrite.
+		CronSchedule("FERRITE_CRON", "example cron schedule variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleCronSchedule_deprecated() {
+	defer example()()
+
+	v := ferrite.
+		CronSchedule("FERRITE_CRON", "example cron schedule variable").
+		Deprecated()
+
+	os.Setenv("FERRITE_CRON", "@Daily")
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_CRON  example cron schedule variable  [ <string> ]  ⚠ deprecated variable set to @Daily, equivalent to @daily
+	//
+	// value is @daily
+}
+
+func ExampleCronSchedule_invalid() {
+	defer example()()
+
+	ferrite.
+		CronSchedule("FERRITE_CRON", "example cron schedule variable").
+		Required()
+
+	os.Setenv("FERRITE_CRON", "0 25 * * *")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_CRON  example cron schedule variable    <string>    ✗ set to '0 25 * * *', the hour field (25) is invalid: 25 is out of range, expected a value between 0 and 23
+	//
+	// <process exited with error code 1>
+}
@@ -41,6 +41,39 @@ func (b *DirBuilder) WithDefault(v string) *DirBuilder {
 	return b
 }
 
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *DirBuilder) WithDefaultFunc(
+	fn func() (v string, ok bool),
+	from ...AnyVariableSet,
+) *DirBuilder {
+	b.builder.DerivedDefault(
+		func() (DirName, bool) {
+			v, ok := fn()
+			return DirName(v), ok
+		},
+		specsOf(from)...,
+	)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *DirBuilder) WithDefaultIn(p *Profile, v string) *DirBuilder {
+	b.builder.ProfileDefault(p.profile, DirName(v))
+	return b
+}
+
 // WithExample adds an example value to the variable's documentation.
 func (b *DirBuilder) WithExample(v string, desc string) *DirBuilder {
 	b.builder.NormativeExample(DirName(v), desc)
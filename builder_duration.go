@@ -3,6 +3,9 @@ package ferrite
 import (
 	"errors"
 	"fmt"
+	"math"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -26,22 +29,16 @@ func Duration(name, desc string) *DurationBuilder {
 
 	b.builder.Name(name)
 	b.builder.Description(desc)
-	b.builder.Documentation().
-		Summary("Duration syntax").
-		Paragraph(
-			"Durations are specified as a sequence of decimal numbers, each with an optional fraction and a unit suffix, such as `300ms`, `-1.5h` or `2h45m`.",
-			"Supported time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`.",
-		).
-		Format().
-		Done()
 
 	return b
 }
 
 // DurationBuilder builds a specification for a duration variable.
 type DurationBuilder struct {
-	schema  variable.TypedNumeric[time.Duration]
-	builder variable.TypedSpecBuilder[time.Duration]
+	schema      variable.TypedNumeric[time.Duration]
+	builder     variable.TypedSpecBuilder[time.Duration]
+	marshaler   durationMarshaler
+	granularity maybe.Value[time.Duration]
 }
 
 var _ isBuilderOf[
@@ -58,6 +55,33 @@ func (b *DurationBuilder) WithDefault(v time.Duration) *DurationBuilder {
 	return b
 }
 
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *DurationBuilder) WithDefaultFunc(
+	fn func() (v time.Duration, ok bool),
+	from ...AnyVariableSet,
+) *DurationBuilder {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *DurationBuilder) WithDefaultIn(p *Profile, v time.Duration) *DurationBuilder {
+	b.builder.ProfileDefault(p.profile, v)
+	return b
+}
+
 // WithExample adds an example value to the variable's documentation.
 func (b *DurationBuilder) WithExample(v time.Duration, desc string) *DurationBuilder {
 	b.builder.NormativeExample(v, desc)
@@ -76,27 +100,167 @@ func (b *DurationBuilder) WithMaximum(v time.Duration) *DurationBuilder {
 	return b
 }
 
+// WithExtendedUnits allows the variable's value to use the `d` (day) and `w`
+// (week) units, in addition to the units supported by [time.ParseDuration].
+//
+// A day is always exactly 24 hours. When this option is used, the canonical
+// representation of durations of one day or longer uses these units.
+func (b *DurationBuilder) WithExtendedUnits() *DurationBuilder {
+	b.marshaler.extendedUnits = true
+	return b
+}
+
+// WithISO8601 allows the variable's value to be specified as an ISO 8601
+// duration, such as `P90D` or `PT15M`.
+//
+// Durations that use the year or month designators are not accepted, as they
+// do not represent a fixed length of time.
+func (b *DurationBuilder) WithISO8601() *DurationBuilder {
+	b.marshaler.iso8601 = true
+	return b
+}
+
+// WithGranularity requires the variable's value to be a whole multiple of g.
+//
+// It is used to reject values with a precision that is meaningless to the
+// application, such as sub-second precision for a retention period.
+func (b *DurationBuilder) WithGranularity(g time.Duration) *DurationBuilder {
+	if g <= 0 {
+		panic("granularity must be positive")
+	}
+
+	b.granularity = maybe.Some(g)
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *DurationBuilder) Required(options ...RequiredOption) Required[time.Duration] {
+	b.finalize()
 	return required(b.schema, &b.builder, options...)
 }
 
 // Optional completes the build process and registers an optional variable with
 // Ferrite's validation system.
 func (b *DurationBuilder) Optional(options ...OptionalOption) Optional[time.Duration] {
+	b.finalize()
 	return optional(b.schema, &b.builder, options...)
 }
 
 // Deprecated completes the build process and registers a deprecated variable
 // with Ferrite's validation system.
 func (b *DurationBuilder) Deprecated(options ...DeprecatedOption) Deprecated[time.Duration] {
+	b.finalize()
 	return deprecated(b.schema, &b.builder, options...)
 }
 
-type durationMarshaler struct{}
+// finalize configures the schema, granularity constraint and documentation
+// according to the options that were enabled on the builder.
+func (b *DurationBuilder) finalize() {
+	b.schema.Marshaler = b.marshaler
+
+	if g, ok := b.granularity.Get(); ok {
+		// The granularity is described using the same syntax as the
+		// variable's value, which depends on WithExtendedUnits().
+		lit, _ := b.marshaler.Marshal(g)
+		desc := fmt.Sprintf("a multiple of %s", lit.String)
+
+		switch g {
+		case time.Second:
+			desc = "a whole number of seconds"
+		case time.Minute:
+			desc = "a whole number of minutes"
+		case time.Hour:
+			desc = "a whole number of hours"
+		case durationDay:
+			desc = "a whole number of days"
+		}
+
+		b.builder.BuiltInConstraint(
+			fmt.Sprintf("**MUST** be %s", desc),
+			func(_ variable.ConstraintContext, v time.Duration) variable.ConstraintError {
+				if v%g != 0 {
+					return fmt.Errorf("expected %s", desc)
+				}
+				return nil
+			},
+		)
+
+		b.builder.Documentation().
+			Paragraph("The value must be %s.").
+			Format(desc).
+			Important().
+			Done()
+	}
+
+	doc := b.builder.Documentation().
+		Summary("Duration syntax").
+		Paragraph(
+			"Durations are specified as a sequence of decimal numbers, each with an optional fraction and a unit suffix, such as `300ms`, `-1.5h` or `2h45m`.",
+			"Supported time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`.",
+		).
+		Format()
+
+	if b.marshaler.extendedUnits {
+		doc = doc.
+			Paragraph(
+				"The `d` (day) and `w` (week) units are also supported, such as `90d` or `2w3d`.",
+				"A day is always exactly 24 hours.",
+			).
+			Format()
+	}
+
+	if b.marshaler.iso8601 {
+		doc = doc.
+			Paragraph(
+				"Durations may also be specified in ISO 8601 format, such as `P90D` or `PT15M`.",
+				"The year and month designators are not supported.",
+			).
+			Format()
+	}
+
+	doc.Done()
+}
+
+type durationMarshaler struct {
+	extendedUnits bool
+	iso8601       bool
+}
+
+const (
+	durationDay  = 24 * time.Hour
+	durationWeek = 7 * durationDay
+)
+
+func (m durationMarshaler) Marshal(v time.Duration) (variable.Literal, error) {
+	if m.extendedUnits && (v >= durationDay || v <= -durationDay) {
+		sign := ""
+		if v < 0 {
+			sign = "-"
+			v = -v
+		}
+
+		if v%durationWeek == 0 {
+			return variable.Literal{
+				String: fmt.Sprintf("%s%dw", sign, v/durationWeek),
+			}, nil
+		}
+
+		days := fmt.Sprintf("%s%dd", sign, v/durationDay)
+
+		rem := v % durationDay
+		if rem == 0 {
+			return variable.Literal{String: days}, nil
+		}
+
+		lit, err := durationMarshaler{}.Marshal(rem)
+		if err != nil {
+			return variable.Literal{}, err
+		}
+
+		return variable.Literal{String: days + lit.String}, nil
+	}
 
-func (durationMarshaler) Marshal(v time.Duration) (variable.Literal, error) {
 	runes := []rune(v.String())
 	zeroes := false
 
@@ -126,23 +290,226 @@ loop:
 	}, nil
 }
 
-func (durationMarshaler) Unmarshal(v variable.Literal) (time.Duration, error) {
-	d, err := time.ParseDuration(strings.ReplaceAll(v.String, " ", ""))
+func (m durationMarshaler) Unmarshal(v variable.Literal) (time.Duration, error) {
+	s := strings.ReplaceAll(v.String, " ", "")
+
+	if m.iso8601 && strings.HasPrefix(s, "P") {
+		return parseISO8601Duration(s)
+	}
+
+	if m.extendedUnits {
+		return parseExtendedDuration(s)
+	}
+
+	d, err := time.ParseDuration(s)
 	if err == nil {
 		return d, nil
 	}
 
-	m := err.Error()
-	if !strings.Contains(m, "unit") {
+	msg := err.Error()
+	if !strings.Contains(msg, "unit") {
 		return 0, err
 	}
 
 	return 0, errors.New(
 		strings.Replace(
-			strings.TrimPrefix(m, "time: "),
-			fmt.Sprintf(` in duration %q`, v.String),
+			strings.TrimPrefix(msg, "time: "),
+			fmt.Sprintf(` in duration %q`, s),
 			"",
 			1,
 		),
 	)
 }
+
+// durationComponentPattern matches a single component of a duration, such as
+// `1.5h` or `2w`.
+var durationComponentPattern = regexp.MustCompile(`^([0-9]*(?:\.[0-9]*)?)([^0-9.]*)`)
+
+// durationUnits is a map of the units that may be used by a duration with
+// extended units enabled, to the length of that unit.
+var durationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"µs": time.Microsecond, // U+00B5 = micro symbol
+	"μs": time.Microsecond, // U+03BC = Greek letter mu
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  durationDay,
+	"w":  durationWeek,
+}
+
+// parseExtendedDuration parses a duration that may use the `d` and `w` units
+// in addition to the units supported by [time.ParseDuration].
+//
+// The duration is parsed without delegating to [time.ParseDuration] so that
+// errors only ever refer to the text within s.
+func parseExtendedDuration(s string) (time.Duration, error) {
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+
+	if s == "0" {
+		return 0, nil
+	}
+
+	if s == "" {
+		return 0, errors.New("invalid duration")
+	}
+
+	var d uint64
+
+	for s != "" {
+		m := durationComponentPattern.FindStringSubmatch(s)
+		n, unit := m[1], m[2]
+		s = s[len(m[0]):]
+
+		if n == "" || n == "." {
+			return 0, errors.New("invalid duration")
+		}
+
+		if unit == "" {
+			return 0, errors.New("missing unit")
+		}
+
+		u, ok := durationUnits[unit]
+		if !ok {
+			return 0, fmt.Errorf("unknown unit %q", unit)
+		}
+
+		c, err := durationComponent(n, u)
+		if err != nil {
+			return 0, err
+		}
+
+		if d, err = addDurations(d, c); err != nil {
+			return 0, err
+		}
+	}
+
+	return signedDuration(d, neg)
+}
+
+// durationComponent returns the magnitude, in nanoseconds, of n units of
+// length u, where n is a non-negative decimal number, such as `15` or `1.5`.
+//
+// The fractional part is computed in the same way as [time.ParseDuration], such
+// that both produce identical results for the units that they have in common.
+//
+// It returns an error if the result exceeds the magnitude of the smallest
+// [time.Duration].
+func durationComponent(n string, u time.Duration) (uint64, error) {
+	whole, frac, _ := strings.Cut(n, ".")
+
+	var v uint64
+
+	if whole != "" {
+		w, err := strconv.ParseUint(whole, 10, 64)
+		if err != nil || w > 1<<63/uint64(u) {
+			return 0, errors.New("duration is too large")
+		}
+		v = w * uint64(u)
+	}
+
+	if frac != "" {
+		f, scale := leadingFraction(frac)
+		v += uint64(float64(f) * (float64(u) / scale))
+		if v > 1<<63 {
+			return 0, errors.New("duration is too large")
+		}
+	}
+
+	return v, nil
+}
+
+// leadingFraction returns the value of the decimal digits in s, and the power
+// of ten by which it must be divided to obtain the fraction that s represents.
+//
+// Digits that would overflow the result are ignored, as per
+// [time.ParseDuration].
+func leadingFraction(s string) (x uint64, scale float64) {
+	scale = 1
+
+	for i := 0; i < len(s); i++ {
+		if x > (1<<63-1)/10 {
+			break
+		}
+
+		y := x*10 + uint64(s[i]-'0')
+		if y > 1<<63 {
+			break
+		}
+
+		x = y
+		scale *= 10
+	}
+
+	return x, scale
+}
+
+// addDurations returns a + b, where a and b are duration magnitudes in
+// nanoseconds.
+//
+// It returns an error if the result exceeds the magnitude of the smallest
+// [time.Duration].
+func addDurations(a, b uint64) (uint64, error) {
+	if a > 1<<63-b {
+		return 0, errors.New("duration is too large")
+	}
+	return a + b, nil
+}
+
+// signedDuration returns the [time.Duration] with the magnitude d, which is
+// negative if neg is true.
+//
+// It returns an error if the result overflows [time.Duration].
+func signedDuration(d uint64, neg bool) (time.Duration, error) {
+	if neg {
+		return -time.Duration(d), nil
+	}
+
+	if d > math.MaxInt64 {
+		return 0, errors.New("duration is too large")
+	}
+
+	return time.Duration(d), nil
+}
+
+// iso8601DurationPattern matches an ISO 8601 duration that does not use the
+// year or month designators.
+var iso8601DurationPattern = regexp.MustCompile(
+	`^P(?:([0-9]+)W)?(?:([0-9]+)D)?(?:T(?:([0-9]+(?:\.[0-9]+)?)H)?(?:([0-9]+(?:\.[0-9]+)?)M)?(?:([0-9]+(?:\.[0-9]+)?)S)?)?$`,
+)
+
+// parseISO8601Duration parses an ISO 8601 duration, such as `P90D` or
+// `PT15M`.
+func parseISO8601Duration(s string) (time.Duration, error) {
+	matches := iso8601DurationPattern.FindStringSubmatch(s)
+	if matches == nil || s == "P" || strings.HasSuffix(s, "T") {
+		if strings.ContainsAny(s, "YM") && !strings.Contains(s, "T") {
+			return 0, errors.New("ISO 8601 durations with years or months are not supported")
+		}
+		return 0, errors.New("invalid ISO 8601 duration")
+	}
+
+	units := []time.Duration{durationWeek, durationDay, time.Hour, time.Minute, time.Second}
+
+	var d uint64
+	for i, unit := range units {
+		if m := matches[i+1]; m != "" {
+			c, err := durationComponent(m, unit)
+			if err != nil {
+				return 0, err
+			}
+
+			if d, err = addDurations(d, c); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return signedDuration(d, false)
+}
@@ -2,6 +2,7 @@ package ferrite_test
 
 import (
 	"fmt"
+	"math"
 	"os"
 	"time"
 
@@ -221,6 +222,208 @@ var _ = Describe("type DurationBuilder", func() {
 			))
 		})
 	})
+
+	When("extended units are enabled", func() {
+		DescribeTable(
+			"it accepts the extended units",
+			func(value string, expect time.Duration) {
+				os.Setenv("FERRITE_DURATION", value)
+
+				v := builder.
+					WithExtendedUnits().
+					Required().
+					Value()
+
+				Expect(v).To(Equal(expect))
+			},
+			Entry("days", "90d", 90*24*time.Hour),
+			Entry("weeks", "2w", 14*24*time.Hour),
+			Entry("fractional days", "1.5d", 36*time.Hour),
+			Entry("mixed units", "1w2d3h", 9*24*time.Hour+3*time.Hour),
+			Entry("standard units", "15m", 15*time.Minute),
+			Entry("fractional weeks", "0.5w", 84*time.Hour),
+		)
+
+		DescribeTable(
+			"it parses durations without extended units identically to time.ParseDuration()",
+			func(value string) {
+				expect, err := time.ParseDuration(value)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				os.Setenv("FERRITE_DURATION", value)
+
+				v := builder.
+					WithExtendedUnits().
+					WithMinimum(math.MinInt64).
+					Required().
+					Value()
+
+				Expect(v).To(Equal(expect))
+			},
+			Entry("long fraction", "0.002335669h"),
+			Entry("fraction with more digits than fit in an integer", "1.00000000000000000000001h"),
+			Entry("fractions in several components", "1.5h30.25m0.001s"),
+			Entry("sub-microsecond fraction", "0.1us"),
+			Entry("negative fraction", "-3.7m"),
+			Entry("maximum duration", "9223372036854775807ns"),
+			Entry("minimum duration", "-9223372036854775808ns"),
+			Entry("minimum duration in several components", "-2562047h47m16.854775808s"),
+		)
+
+		DescribeTable(
+			"it rejects invalid durations without referring to text that is not in the value",
+			func(value, expect string) {
+				os.Setenv("FERRITE_DURATION", value)
+
+				Expect(func() {
+					builder.
+						WithExtendedUnits().
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"missing units",
+				"2w3",
+				`value of FERRITE_DURATION (2w3) is invalid: missing unit`,
+			),
+			Entry(
+				"unknown units",
+				"2w3q",
+				`value of FERRITE_DURATION (2w3q) is invalid: unknown unit "q"`,
+			),
+			Entry(
+				"overflow in a single component",
+				"200000w",
+				`value of FERRITE_DURATION (200000w) is invalid: duration is too large`,
+			),
+			Entry(
+				"overflow in the total",
+				"15000w15000w",
+				`value of FERRITE_DURATION (15000w15000w) is invalid: duration is too large`,
+			),
+		)
+	})
+
+	When("ISO 8601 durations are enabled", func() {
+		DescribeTable(
+			"it accepts ISO 8601 durations",
+			func(value string, expect time.Duration) {
+				os.Setenv("FERRITE_DURATION", value)
+
+				v := builder.
+					WithISO8601().
+					Required().
+					Value()
+
+				Expect(v).To(Equal(expect))
+			},
+			Entry("days", "P90D", 90*24*time.Hour),
+			Entry("weeks", "P2W", 14*24*time.Hour),
+			Entry("minutes", "PT15M", 15*time.Minute),
+			Entry("fractional seconds", "PT1.5S", 1500*time.Millisecond),
+			Entry("date and time", "P1DT2H30M", 26*time.Hour+30*time.Minute),
+			Entry("standard syntax", "15m", 15*time.Minute),
+		)
+
+		DescribeTable(
+			"it rejects invalid ISO 8601 durations",
+			func(value, expect string) {
+				os.Setenv("FERRITE_DURATION", value)
+
+				Expect(func() {
+					builder.
+						WithISO8601().
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"years",
+				"P1Y",
+				`value of FERRITE_DURATION (P1Y) is invalid: ISO 8601 durations with years or months are not supported`,
+			),
+			Entry(
+				"months",
+				"P3M",
+				`value of FERRITE_DURATION (P3M) is invalid: ISO 8601 durations with years or months are not supported`,
+			),
+			Entry(
+				"no components",
+				"PT",
+				`value of FERRITE_DURATION (PT) is invalid: invalid ISO 8601 duration`,
+			),
+			Entry(
+				"overflow in a single component",
+				"P100000W",
+				`value of FERRITE_DURATION (P100000W) is invalid: duration is too large`,
+			),
+			Entry(
+				"overflow in the total",
+				"P10000WT2000000H",
+				`value of FERRITE_DURATION (P10000WT2000000H) is invalid: duration is too large`,
+			),
+		)
+	})
+
+	When("there is a granularity", func() {
+		It("accepts values that are a multiple of the granularity", func() {
+			os.Setenv("FERRITE_DURATION", "15m")
+
+			v := builder.
+				WithGranularity(time.Minute).
+				Required().
+				Value()
+
+			Expect(v).To(Equal(15 * time.Minute))
+		})
+
+		DescribeTable(
+			"it panics if the value is not a multiple of the granularity",
+			func(g time.Duration, value, expect string) {
+				os.Setenv("FERRITE_DURATION", value)
+
+				Expect(func() {
+					builder.
+						WithGranularity(g).
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"seconds",
+				time.Second,
+				"1500ms",
+				`value of FERRITE_DURATION (1500ms) is invalid: expected a whole number of seconds`,
+			),
+			Entry(
+				"minutes",
+				time.Minute,
+				"90s",
+				`value of FERRITE_DURATION (90s) is invalid: expected a whole number of minutes`,
+			),
+			Entry(
+				"arbitrary",
+				15*time.Minute,
+				"20m",
+				`value of FERRITE_DURATION (20m) is invalid: expected a multiple of 15m`,
+			),
+		)
+
+		It("describes the granularity using extended units regardless of the order of the builder methods", func() {
+			os.Setenv("FERRITE_DURATION", "1d")
+
+			Expect(func() {
+				builder.
+					WithGranularity(48 * time.Hour).
+					WithExtendedUnits().
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_DURATION (1d) is invalid: expected a multiple of 2d`,
+			))
+		})
+	})
 })
 
 func ExampleDuration_required() {
@@ -315,3 +518,30 @@ func ExampleDuration_deprecated() {
 	//
 	// value is 10m30s
 }
+
+func ExampleDuration_extendedSyntax() {
+	defer example()()
+
+	v := ferrite.
+		Duration("FERRITE_DURATION", "example duration variable").
+		WithExtendedUnits().
+		WithISO8601().
+		WithGranularity(24 * time.Hour).
+		Deprecated()
+
+	os.Setenv("FERRITE_DURATION", "P90D")
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_DURATION  example duration variable  [ 1ns ... ]  ⚠ deprecated variable set to P90D, equivalent to 90d
+	//
+	// value is 2160h0m0s
+}
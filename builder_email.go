@@ -0,0 +1,270 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"reflect"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/inflect"
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// EmailAddress configures an environment variable as an email address.
+//
+// The value may be a bare address, such as "alice@example.org", or an address
+// with a display name, such as "Alice <alice@example.org>".
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func EmailAddress(name, desc string) *EmailAddressBuilder {
+	b := &EmailAddressBuilder{}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.Documentation().
+		Summary("Email address syntax").
+		Paragraph(
+			"Email addresses are specified using the syntax described in RFC 5322, such as `alice@example.org`.",
+			"The address may optionally be accompanied by a display name, such as `Alice <alice@example.org>`.",
+		).
+		Format().
+		Done()
+
+	return b
+}
+
+// EmailAddressBuilder builds a specification for an email address variable.
+type EmailAddressBuilder struct {
+	schema  emailAddressSchema
+	builder variable.TypedSpecBuilder[*mail.Address]
+
+	constraints userConstraints[*mail.Address]
+}
+
+var _ isBuilderOf[
+	*mail.Address,
+	string,
+	*EmailAddressBuilder,
+]
+
+var _ isFinalizedBuilder[*EmailAddressBuilder]
+
+// WithDefault sets the default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *EmailAddressBuilder) WithDefault(v string) *EmailAddressBuilder {
+	b.builder.Default(mustParseEmailAddress(v))
+	return b
+}
+
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *EmailAddressBuilder) WithDefaultFunc(
+	fn func() (v *mail.Address, ok bool),
+	from ...AnyVariableSet,
+) *EmailAddressBuilder {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *EmailAddressBuilder) WithDefaultIn(p *Profile, v string) *EmailAddressBuilder {
+	b.builder.ProfileDefault(p.profile, mustParseEmailAddress(v))
+	return b
+}
+
+// WithExample adds an example value to the variable's documentation.
+func (b *EmailAddressBuilder) WithExample(v string, desc string) *EmailAddressBuilder {
+	b.builder.NormativeExample(mustParseEmailAddress(v), desc)
+	return b
+}
+
+// WithAllowedDomains restricts the variable to addresses within the given
+// domains.
+//
+// Domains are compared without regard to case. Subdomains of the given domains
+// are not allowed unless they are listed explicitly.
+func (b *EmailAddressBuilder) WithAllowedDomains(domains ...string) *EmailAddressBuilder {
+	b.schema.AllowedDomains = append(b.schema.AllowedDomains, domains...)
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the environment variable value after it is parsed. If fn
+// returns false the value is considered invalid.
+func (b *EmailAddressBuilder) WithConstraint(
+	desc string,
+	fn func(*mail.Address) bool,
+) *EmailAddressBuilder {
+	b.constraints.add(desc, fn)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *EmailAddressBuilder) Required(options ...RequiredOption) Required[*mail.Address] {
+	b.finalize()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *EmailAddressBuilder) Optional(options ...OptionalOption) Optional[*mail.Address] {
+	b.finalize()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *EmailAddressBuilder) Deprecated(options ...DeprecatedOption) Deprecated[*mail.Address] {
+	b.finalize()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+// finalize adds the constraint on the permitted email domains.
+func (b *EmailAddressBuilder) finalize() {
+	domains := b.schema.AllowedDomains
+
+	desc := "**MUST** be a valid email address"
+	if len(domains) != 0 {
+		quoted := make([]string, len(domains))
+		for i, d := range domains {
+			quoted[i] = "`" + d + "`"
+		}
+
+		desc += " in the " + inflect.OrList(quoted) + " " +
+			inflect.Pluralize("domain", len(domains))
+	}
+
+	b.builder.BuiltInConstraint(
+		desc,
+		func(_ variable.ConstraintContext, v *mail.Address) variable.ConstraintError {
+			if len(domains) == 0 {
+				return nil
+			}
+
+			domain := emailDomain(v)
+			for _, d := range domains {
+				if strings.EqualFold(domain, d) {
+					return nil
+				}
+			}
+
+			return fmt.Errorf(
+				"expected an address in the %s %s",
+				inflect.OrList(domains),
+				inflect.Pluralize("domain", len(domains)),
+			)
+		},
+	)
+
+	b.constraints.apply(&b.builder)
+}
+
+// emailAddressSchema is the schema for an [EmailAddress] variable.
+type emailAddressSchema struct {
+	AllowedDomains []string
+}
+
+func (s emailAddressSchema) Type() reflect.Type {
+	return reflectx.TypeOf[*mail.Address]()
+}
+
+func (s emailAddressSchema) Finalize() error {
+	for _, d := range s.AllowedDomains {
+		if d == "" || strings.Contains(d, "@") {
+			return fmt.Errorf("allowed domain %q is invalid", d)
+		}
+	}
+
+	return nil
+}
+
+func (s emailAddressSchema) AcceptVisitor(v variable.SchemaVisitor) {
+	v.VisitOther(s)
+}
+
+func (s emailAddressSchema) Marshal(v *mail.Address) (variable.Literal, error) {
+	if v.Name == "" {
+		return variable.Literal{String: v.Address}, nil
+	}
+
+	// Prefer to render the display name without quotes, as it would typically
+	// be written by a human, but only if doing so does not change its meaning.
+	unquoted := v.Name + " <" + v.Address + ">"
+	if a, err := mail.ParseAddress(unquoted); err == nil && *a == *v {
+		return variable.Literal{String: unquoted}, nil
+	}
+
+	return variable.Literal{String: v.String()}, nil
+}
+
+func (s emailAddressSchema) Unmarshal(v variable.Literal) (*mail.Address, error) {
+	a, err := mail.ParseAddress(v.String)
+	if err != nil {
+		return nil, errors.New("expected an email address, such as alice@example.org")
+	}
+	return a, nil
+}
+
+func (s emailAddressSchema) Examples(conservative bool) []variable.TypedExample[*mail.Address] {
+	domain := "example.org"
+	if len(s.AllowedDomains) != 0 {
+		domain = s.AllowedDomains[0]
+	}
+
+	examples := []variable.TypedExample[*mail.Address]{
+		{
+			Native: &mail.Address{
+				Address: "alice@" + domain,
+			},
+			Description: "a bare email address",
+		},
+	}
+
+	if !conservative {
+		examples = append(
+			examples,
+			variable.TypedExample[*mail.Address]{
+				Native: &mail.Address{
+					Name:    "Alice Jones",
+					Address: "alice@" + domain,
+				},
+				Description: "an email address with a display name",
+			},
+		)
+	}
+
+	return examples
+}
+
+// emailDomain returns the domain portion of an email address.
+func emailDomain(v *mail.Address) string {
+	if i := strings.LastIndexByte(v.Address, '@'); i != -1 {
+		return v.Address[i+1:]
+	}
+	return ""
+}
+
+func mustParseEmailAddress(v string) *mail.Address {
+	a, err := mail.ParseAddress(v)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
@@ -0,0 +1,241 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"net/mail"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type EmailAddressBuilder", func() {
+	var builder *EmailAddressBuilder
+
+	BeforeEach(func() {
+		builder = EmailAddress("FERRITE_EMAIL", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			EmailAddress("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			EmailAddress("FERRITE_EMAIL", "").Optional()
+		}).To(PanicWith("specification for FERRITE_EMAIL is invalid: variable description must not be empty"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the address",
+					func(value string, expect mail.Address) {
+						os.Setenv("FERRITE_EMAIL", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(*v).To(Equal(expect))
+					},
+					Entry(
+						"bare address",
+						"alice@example.org",
+						mail.Address{Address: "alice@example.org"},
+					),
+					Entry(
+						"address with display name",
+						"Alice Jones <alice@example.org>",
+						mail.Address{Name: "Alice Jones", Address: "alice@example.org"},
+					),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				It("panics", func() {
+					os.Setenv("FERRITE_EMAIL", "alice")
+
+					Expect(func() {
+						builder.
+							Required().
+							Value()
+					}).To(PanicWith(
+						`value of FERRITE_EMAIL (alice) is invalid: expected an email address, such as alice@example.org`,
+					))
+				})
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("alice@example.org").
+							Required().
+							Value()
+
+						Expect(v.Address).To(Equal("alice@example.org"))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_EMAIL is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("there are allowed domains", func() {
+		BeforeEach(func() {
+			builder.WithAllowedDomains("example.org", "example.com")
+		})
+
+		It("accepts addresses in an allowed domain regardless of case", func() {
+			os.Setenv("FERRITE_EMAIL", "alice@EXAMPLE.com")
+
+			v := builder.
+				Required().
+				Value()
+
+			Expect(v.Address).To(Equal("alice@EXAMPLE.com"))
+		})
+
+		It("panics if the address is in some other domain", func() {
+			os.Setenv("FERRITE_EMAIL", "alice@mail.example.org")
+
+			Expect(func() {
+				builder.
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_EMAIL (alice@mail.example.org) is invalid: expected an address in the example.org or example.com domains`,
+			))
+		})
+
+		It("panics if an allowed domain is invalid", func() {
+			Expect(func() {
+				builder.
+					WithAllowedDomains("@example.net").
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_EMAIL is invalid: allowed domain "@example.net" is invalid`,
+			))
+		})
+	})
+})
+
+func ExampleEmailAddress_required() {
+	defer example()()
+
+	v := ferrite.
+		EmailAddress("FERRITE_EMAIL", "example email address variable").
+		Required()
+
+	os.Setenv("FERRITE_EMAIL", "Alice Jones <alice@example.org>")
+	ferrite.Init()
+
+	fmt.Println("name is", v.Value().Name)
+	fmt.Println("address is", v.Value().Address)
+
+	// Output:
+	// name is Alice Jones
+	// address is alice@example.org
+}
+
+func ExampleEmailAddress_default() {
+	defer example()()
+
+	v := ferrite.
+		EmailAddress("FERRITE_EMAIL", "example email address variable").
+		WithDefault("alice@example.org").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("address is", v.Value().Address)
+
+	// Output:
+	// address is alice@example.org
+}
+
+func ExampleEmailAddress_optional() {
+	defer example()()
+
+	v := ferrite.
+		EmailAddress("FERRITE_EMAIL", "example email address variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("address is", x.Address)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleEmailAddress_deprecated() {
+	defer example()()
+
+	v := ferrite.
+		EmailAddress("FERRITE_EMAIL", "example email address variable").
+		Deprecated()
+
+	os.Setenv("FERRITE_EMAIL", "alice@example.org")
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("address is", x.Address)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_EMAIL  example email address variable  [ <string> ]  ⚠ deprecated variable set to alice@example.org
+	//
+	// address is alice@example.org
+}
@@ -73,6 +73,31 @@ func (b *EnumBuilder[T]) WithMember(v T, desc string) *EnumBuilder[T] {
 	return b
 }
 
+// WithAliases adds alternative literals that are accepted in place of the
+// member v.
+//
+// The member's primary literal representation is still used as the canonical
+// value. v must be a member of the enum.
+func (b *EnumBuilder[T]) WithAliases(v T, aliases ...string) *EnumBuilder[T] {
+	for _, a := range aliases {
+		b.schema.MemberAliases = append(
+			b.schema.MemberAliases,
+			variable.SetAlias[T]{
+				Value:   v,
+				Literal: variable.Literal{String: a},
+			},
+		)
+	}
+	return b
+}
+
+// WithCaseInsensitiveMatching causes the variable's value to be matched against
+// the members of the enum (and their aliases) without regard to case.
+func (b *EnumBuilder[T]) WithCaseInsensitiveMatching() *EnumBuilder[T] {
+	b.schema.CaseInsensitive = true
+	return b
+}
+
 // WithRenderer sets the function used to generate the literal string
 // representation of the enum's member values.
 func (b *EnumBuilder[T]) WithRenderer(fn func(T) variable.Literal) *EnumBuilder[T] {
@@ -88,6 +113,43 @@ func (b *EnumBuilder[T]) WithDefault(v T) *EnumBuilder[T] {
 	return b
 }
 
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *EnumBuilder[T]) WithDefaultFunc(
+	fn func() (v T, ok bool),
+	from ...AnyVariableSet,
+) *EnumBuilder[T] {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *EnumBuilder[T]) WithDefaultIn(p *Profile, v T) *EnumBuilder[T] {
+	b.builder.ProfileDefault(p.profile, v)
+	return b
+}
+
+// WithNormalizers adds normalizers that transform the variable's value before
+// it is parsed and validated.
+//
+// Normalizers are applied in the order they are given. The original value is
+// still shown in validation output.
+func (b *EnumBuilder[T]) WithNormalizers(n ...Normalizer) *EnumBuilder[T] {
+	addNormalizers(&b.builder, n)
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *EnumBuilder[T]) Required(options ...RequiredOption) Required[T] {
@@ -212,6 +212,136 @@ var _ = Describe("type EnumBuilder", func() {
 		})
 	})
 
+	When("there are aliases", func() {
+		It("returns the value associated with the alias", func() {
+			os.Setenv("FERRITE_ENUM", "<one>")
+
+			v := builder.
+				WithAliases(member1, "<first>", "<one>").
+				Required().
+				Value()
+
+			Expect(v).To(Equal(member1))
+		})
+
+		It("panics if the aliased value is not a member of the enum", func() {
+			Expect(func() {
+				builder.
+					WithAliases(enumMember(100), "<hundred>").
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_ENUM is invalid: alias "<hundred>" refers to "<member-100>", which is not a member of the set`,
+			))
+		})
+
+		It("panics if an alias is the same as another member's literal", func() {
+			Expect(func() {
+				builder.
+					WithAliases(member1, "<member-2>").
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_ENUM is invalid: literals must be unique but multiple values are represented as "<member-2>"`,
+			))
+		})
+	})
+
+	When("matching is case-insensitive", func() {
+		It("returns the value associated with the literal", func() {
+			os.Setenv("FERRITE_ENUM", "<MEMBER-1>")
+
+			v := builder.
+				WithCaseInsensitiveMatching().
+				Required().
+				Value()
+
+			Expect(v).To(Equal(member1))
+		})
+
+		It("returns the value associated with an alias", func() {
+			os.Setenv("FERRITE_ENUM", "<ONE>")
+
+			v := builder.
+				WithAliases(member1, "<one>").
+				WithCaseInsensitiveMatching().
+				Required().
+				Value()
+
+			Expect(v).To(Equal(member1))
+		})
+
+		It("panics if literals differ only in case", func() {
+			Expect(func() {
+				builder.
+					WithAliases(member1, "<MEMBER-2>").
+					WithCaseInsensitiveMatching().
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_ENUM is invalid: literals must be unique but multiple values are represented as "<MEMBER-2>"`,
+			))
+		})
+	})
+
+	When("the value is a near miss", func() {
+		DescribeTable(
+			"it suggests the most similar literal",
+			func(value, expect string) {
+				os.Setenv("FERRITE_ENUM", value)
+
+				Expect(func() {
+					builder.
+						WithAliases(member2, "<two>").
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"typo in a member's literal",
+				"<membr-1>",
+				`value of FERRITE_ENUM ('<membr-1>') is invalid: did you mean '<member-1>'?`,
+			),
+			Entry(
+				"typo in an alias",
+				"<tow>",
+				`value of FERRITE_ENUM ('<tow>') is invalid: did you mean '<two>'?`,
+			),
+			Entry(
+				"incorrect case",
+				"<MEMBER-0>",
+				`value of FERRITE_ENUM ('<MEMBER-0>') is invalid: did you mean '<member-0>'?`,
+			),
+			Entry(
+				"not similar to any literal",
+				"<unrelated>",
+				`value of FERRITE_ENUM ('<unrelated>') is invalid: expected '<member-0>', '<member-1>' or '<member-2>'`,
+			),
+		)
+	})
+
+	When("there are normalizers", func() {
+		It("normalizes the value before matching it against the members", func() {
+			os.Setenv("FERRITE_ENUM", " <MEMBER-1> ")
+
+			v := builder.
+				WithNormalizers(TrimSpace(), ToLower()).
+				Required().
+				Value()
+
+			Expect(v).To(Equal(member1))
+		})
+
+		It("treats a value that normalizes to an empty string as undefined", func() {
+			os.Setenv("FERRITE_ENUM", "   ")
+
+			v := builder.
+				WithNormalizers(TrimSpace()).
+				WithDefault(member2).
+				Required().
+				Value()
+
+			Expect(v).To(Equal(member2))
+		})
+	})
+
 	When("the default value is not a member of the enum", func() {
 		It("panics", func() {
 			Expect(func() {
@@ -323,3 +453,75 @@ func ExampleEnum_deprecated() {
 	//
 	// value is red
 }
+
+func ExampleEnum_normalizers() {
+	defer example()()
+
+	v := ferrite.
+		Enum("FERRITE_ENUM", "example enum variable").
+		WithMembers("red", "green", "blue").
+		WithNormalizers(ferrite.TrimSpace(), ferrite.ToLower()).
+		Deprecated()
+
+	os.Setenv("FERRITE_ENUM", " Red ")
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_ENUM  example enum variable  [ red | green | blue ]  ⚠ deprecated variable set to ' Red ', equivalent to red
+	//
+	// value is red
+}
+
+func ExampleEnum_aliases() {
+	defer example()()
+
+	v := ferrite.
+		Enum("FERRITE_ENUM", "example enum variable").
+		WithMembers("production", "staging", "development").
+		WithAliases("production", "prod").
+		WithAliases("development", "dev").
+		Deprecated()
+
+	os.Setenv("FERRITE_ENUM", "prod")
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_ENUM  example enum variable  [ production | staging | development ]  ⚠ deprecated variable set to prod, equivalent to production
+	//
+	// value is production
+}
+
+func ExampleEnum_nearMiss() {
+	defer example()()
+
+	ferrite.
+		Enum("FERRITE_ENUM", "example enum variable").
+		WithMembers("production", "staging", "development").
+		Required()
+
+	os.Setenv("FERRITE_ENUM", "prodution")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_ENUM  example enum variable    production | staging | development    ✗ set to prodution, did you mean production?
+	//
+	// <process exited with error code 1>
+}
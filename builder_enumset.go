@@ -0,0 +1,512 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/inflect"
+	"github.com/dogmatiq/ferrite/internal/maybe"
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// MemberSet is a set of enumeration members, as produced by an [EnumSet]
+// variable.
+type MemberSet[T comparable] struct {
+	members []T
+}
+
+// Has returns true if v is a member of the set.
+func (s MemberSet[T]) Has(v T) bool {
+	for _, m := range s.members {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of members in the set.
+func (s MemberSet[T]) Len() int {
+	return len(s.members)
+}
+
+// Members returns the members of the set, in the order they were added to the
+// variable's specification.
+func (s MemberSet[T]) Members() []T {
+	return append([]T(nil), s.members...)
+}
+
+const (
+	// enumSetAllKeyword is the literal value that selects every member of an
+	// enum set.
+	enumSetAllKeyword = "all"
+
+	// enumSetNoneKeyword is the literal value that selects no members of an
+	// enum set.
+	enumSetNoneKeyword = "none"
+)
+
+// EnumSet configures an environment variable as a set of zero or more members
+// of an enumeration.
+//
+// The value is a list of members separated by commas. The special values "all"
+// and "none" select every member, or no members, respectively.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func EnumSet(name, desc string) *EnumSetBuilder[string] {
+	return EnumSetAs[string](name, desc)
+}
+
+// EnumSetAs configures an environment variable as a set of zero or more
+// members of an enumeration with members of type T.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func EnumSetAs[T comparable](name, desc string) *EnumSetBuilder[T] {
+	b := &EnumSetBuilder[T]{
+		schema: enumSetSchema[T]{
+			Set: variable.TypedSet[T]{
+				ToLiteral: func(v T) variable.Literal {
+					return variable.Literal{
+						String: fmt.Sprint(v),
+					}
+				},
+			},
+			Separator: ",",
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// EnumSetBuilder is the specification for a set of enumeration members.
+type EnumSetBuilder[T comparable] struct {
+	schema      enumSetSchema[T]
+	builder     variable.TypedSpecBuilder[MemberSet[T]]
+	def         maybe.Value[[]T]
+	profileDefs []profileDefault[[]T]
+
+	constraints userConstraints[MemberSet[T]]
+}
+
+var _ isBuilderOfMinimal[
+	MemberSet[int],
+	*EnumSetBuilder[int],
+]
+
+var _ isFinalizedBuilder[*EnumSetBuilder[int]]
+
+// WithMembers adds members to the enum.
+//
+// The values must not have an empty string representation.
+func (b *EnumSetBuilder[T]) WithMembers(values ...T) *EnumSetBuilder[T] {
+	for _, v := range values {
+		b.WithMember(v, "")
+	}
+	return b
+}
+
+// WithMember adds a member to the enum.
+//
+// v must not have an empty string representation.
+func (b *EnumSetBuilder[T]) WithMember(v T, desc string) *EnumSetBuilder[T] {
+	b.schema.Set.Members = append(
+		b.schema.Set.Members,
+		variable.SetMember[T]{
+			Value:       v,
+			Description: desc,
+		},
+	)
+	return b
+}
+
+// WithRenderer sets the function used to generate the literal string
+// representation of the enum's member values.
+func (b *EnumSetBuilder[T]) WithRenderer(fn func(T) variable.Literal) *EnumSetBuilder[T] {
+	b.schema.Set.ToLiteral = fn
+	return b
+}
+
+// WithSeparator sets the string used to separate members within the
+// variable's value.
+//
+// The default separator is a comma.
+func (b *EnumSetBuilder[T]) WithSeparator(sep string) *EnumSetBuilder[T] {
+	b.schema.Separator = sep
+	return b
+}
+
+// WithDefault sets the default value of the variable.
+//
+// It is used when the environment variable is undefined or empty. If no
+// values are given the default is an empty set.
+func (b *EnumSetBuilder[T]) WithDefault(values ...T) *EnumSetBuilder[T] {
+	b.def = maybe.Some(values)
+	return b
+}
+
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// fn returns the members that are selected by default.
+//
+// See also [WithDefaultFrom].
+func (b *EnumSetBuilder[T]) WithDefaultFunc(
+	fn func() (v []T, ok bool),
+	from ...AnyVariableSet,
+) *EnumSetBuilder[T] {
+	b.def = maybe.None[[]T]()
+	b.builder.DerivedDefault(
+		func() (MemberSet[T], bool) {
+			values, ok := fn()
+			if !ok {
+				return MemberSet[T]{}, false
+			}
+			return newMemberSet(b.schema.Set, values), true
+		},
+		specsOf(from)...,
+	)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *EnumSetBuilder[T]) WithDefaultIn(p *Profile, values ...T) *EnumSetBuilder[T] {
+	b.profileDefs = append(b.profileDefs, profileDefault[[]T]{p, values})
+	return b
+}
+
+// WithMinimumSelections sets the minimum number of members that must be
+// selected.
+func (b *EnumSetBuilder[T]) WithMinimumSelections(n int) *EnumSetBuilder[T] {
+	b.schema.Min = maybe.Some(n)
+	return b
+}
+
+// WithMaximumSelections sets the maximum number of members that may be
+// selected.
+func (b *EnumSetBuilder[T]) WithMaximumSelections(n int) *EnumSetBuilder[T] {
+	b.schema.Max = maybe.Some(n)
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the environment variable value after it is parsed. If fn
+// returns false the value is considered invalid.
+func (b *EnumSetBuilder[T]) WithConstraint(
+	desc string,
+	fn func(MemberSet[T]) bool,
+) *EnumSetBuilder[T] {
+	b.constraints.add(desc, fn)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *EnumSetBuilder[T]) Required(options ...RequiredOption) Required[MemberSet[T]] {
+	b.finalize()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *EnumSetBuilder[T]) Optional(options ...OptionalOption) Optional[MemberSet[T]] {
+	b.finalize()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *EnumSetBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[MemberSet[T]] {
+	b.finalize()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+// finalize adds the constraint on the number of selected members, and
+// documents the keywords that are within those limits.
+func (b *EnumSetBuilder[T]) finalize() {
+	min, hasMin := b.schema.Min.Get()
+	max, hasMax := b.schema.Max.Get()
+
+	var w strings.Builder
+	w.WriteString("**MUST** be ")
+	if b.schema.Separator == "," {
+		w.WriteString("a comma-separated list of ")
+	} else {
+		fmt.Fprintf(&w, "a `%s`-separated list of ", b.schema.Separator)
+	}
+
+	if hasMin && hasMax {
+		if min == max {
+			fmt.Fprintf(&w, "exactly %d of ", min)
+		} else {
+			fmt.Fprintf(&w, "between %d and %d of ", min, max)
+		}
+	} else if hasMin {
+		fmt.Fprintf(&w, "at least %d of ", min)
+	} else if hasMax {
+		fmt.Fprintf(&w, "at most %d of ", max)
+	}
+
+	w.WriteString("the members shown in the examples below")
+
+	b.builder.BuiltInConstraint(
+		w.String(),
+		func(_ variable.ConstraintContext, v MemberSet[T]) variable.ConstraintError {
+			n := v.Len()
+
+			if hasMin && n < min {
+				return fmt.Errorf(
+					"expected at least %d %s, got %d",
+					min,
+					inflect.Pluralize("member", min),
+					n,
+				)
+			}
+
+			if hasMax && n > max {
+				return fmt.Errorf(
+					"expected at most %d %s, got %d",
+					max,
+					inflect.Pluralize("member", max),
+					n,
+				)
+			}
+
+			return nil
+		},
+	)
+
+	b.constraints.apply(&b.builder)
+
+	// The keywords are only documented if the sets they select are within
+	// the selection limits.
+	allowAll := !hasMax || len(b.schema.Set.Members) <= max
+	allowNone := !hasMin || min == 0
+
+	switch {
+	case allowAll && allowNone:
+		b.builder.Documentation().
+			Paragraph(
+				"The keyword `%s` may be used to select every member,",
+				"and the keyword `%s` may be used to explicitly select no members.",
+			).
+			Format(enumSetAllKeyword, enumSetNoneKeyword).
+			Important().
+			Done()
+	case allowAll:
+		b.builder.Documentation().
+			Paragraph("The keyword `%s` may be used to select every member.").
+			Format(enumSetAllKeyword).
+			Important().
+			Done()
+	case allowNone:
+		b.builder.Documentation().
+			Paragraph("The keyword `%s` may be used to explicitly select no members.").
+			Format(enumSetNoneKeyword).
+			Important().
+			Done()
+	}
+
+	if values, ok := b.def.Get(); ok {
+		b.builder.Default(newMemberSet(b.schema.Set, values))
+	}
+
+	for _, d := range b.profileDefs {
+		b.builder.ProfileDefault(d.profile.profile, newMemberSet(b.schema.Set, d.value))
+	}
+}
+
+// newMemberSet returns a MemberSet containing the given values, ordered
+// according to the order of the members in set.
+//
+// Any values that are not members of the set are retained at the end, such
+// that they are reported as errors when the set is marshaled.
+func newMemberSet[T comparable](set variable.TypedSet[T], values []T) MemberSet[T] {
+	var s MemberSet[T]
+
+	for _, m := range set.Members {
+		for _, v := range values {
+			if v == m.Value {
+				s.members = append(s.members, m.Value)
+				break
+			}
+		}
+	}
+
+	for _, v := range values {
+		if !s.Has(v) {
+			s.members = append(s.members, v)
+		}
+	}
+
+	return s
+}
+
+// enumSetSchema is the schema for an [EnumSet] variable.
+type enumSetSchema[T comparable] struct {
+	Set       variable.TypedSet[T]
+	Separator string
+	Min, Max  maybe.Value[int]
+}
+
+func (s enumSetSchema[T]) Type() reflect.Type {
+	return reflectx.TypeOf[MemberSet[T]]()
+}
+
+func (s enumSetSchema[T]) Finalize() error {
+	if err := s.Set.Finalize(); err != nil {
+		return err
+	}
+
+	if s.Separator == "" {
+		return errors.New("separator must not be empty")
+	}
+
+	min, hasMin := s.Min.Get()
+	max, hasMax := s.Max.Get()
+
+	if hasMin && min < 0 {
+		return errors.New("minimum selections must not be negative")
+	}
+
+	if hasMax && max < 0 {
+		return errors.New("maximum selections must not be negative")
+	}
+
+	if hasMin && hasMax && min > max {
+		return fmt.Errorf(
+			"minimum selections (%d) must not be greater than maximum selections (%d)",
+			min,
+			max,
+		)
+	}
+
+	if n := len(s.Set.Members); hasMin && min > n {
+		return fmt.Errorf(
+			"minimum selections (%d) must not be greater than the number of members (%d)",
+			min,
+			n,
+		)
+	}
+
+	for _, lit := range s.Set.Literals() {
+		if lit.String == enumSetAllKeyword || lit.String == enumSetNoneKeyword {
+			return fmt.Errorf(
+				"%q is a reserved keyword and can not be used as a member",
+				lit.String,
+			)
+		}
+
+		if strings.Contains(lit.String, s.Separator) {
+			return fmt.Errorf(
+				"%q contains the separator (%q) and can not be used as a member",
+				lit.String,
+				s.Separator,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (s enumSetSchema[T]) AcceptVisitor(v variable.SchemaVisitor) {
+	v.VisitOther(s)
+}
+
+func (s enumSetSchema[T]) Marshal(v MemberSet[T]) (variable.Literal, error) {
+	if v.Len() == 0 {
+		return variable.Literal{String: enumSetNoneKeyword}, nil
+	}
+
+	parts := make([]string, v.Len())
+	for i, m := range v.members {
+		lit, err := s.Set.Marshal(m)
+		if err != nil {
+			return variable.Literal{}, err
+		}
+		parts[i] = lit.String
+	}
+
+	return variable.Literal{
+		String: strings.Join(parts, s.Separator),
+	}, nil
+}
+
+func (s enumSetSchema[T]) Unmarshal(v variable.Literal) (MemberSet[T], error) {
+	switch v.String {
+	case enumSetAllKeyword:
+		var all []T
+		for _, m := range s.Set.Members {
+			all = append(all, m.Value)
+		}
+		return MemberSet[T]{all}, nil
+	case enumSetNoneKeyword:
+		return MemberSet[T]{}, nil
+	}
+
+	var values []T
+
+	for i, p := range strings.Split(v.String, s.Separator) {
+		p = strings.TrimSpace(p)
+
+		if p == "" {
+			return MemberSet[T]{}, fmt.Errorf("member %d is empty", i+1)
+		}
+
+		m, err := s.Set.Unmarshal(variable.Literal{String: p})
+		if err != nil {
+			return MemberSet[T]{}, fmt.Errorf(
+				"member %d (%s) is invalid: %w",
+				i+1,
+				variable.Literal{String: p}.Quote(),
+				err,
+			)
+		}
+
+		values = append(values, m)
+	}
+
+	return newMemberSet(s.Set, values), nil
+}
+
+func (s enumSetSchema[T]) Examples(conservative bool) []variable.TypedExample[MemberSet[T]] {
+	var examples []variable.TypedExample[MemberSet[T]]
+
+	for _, m := range s.Set.Members {
+		examples = append(examples, variable.TypedExample[MemberSet[T]]{
+			Native:      MemberSet[T]{[]T{m.Value}},
+			Description: m.Description,
+			IsNormative: true,
+		})
+	}
+
+	if !conservative && len(s.Set.Members) > 1 {
+		examples = append(examples, variable.TypedExample[MemberSet[T]]{
+			Native: MemberSet[T]{[]T{
+				s.Set.Members[0].Value,
+				s.Set.Members[1].Value,
+			}},
+			Description: "multiple members",
+			IsNormative: true,
+		})
+	}
+
+	return examples
+}
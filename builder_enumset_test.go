@@ -0,0 +1,370 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type EnumSetBuilder", func() {
+	var builder *EnumSetBuilder[enumMember]
+
+	BeforeEach(func() {
+		builder = EnumSetAs[enumMember]("FERRITE_ENUM_SET", "<desc>").
+			WithMember(member0, "<desc-of-0>").
+			WithMember(member1, "<desc-of-1>").
+			WithMember(member2, "<desc-of-2>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			EnumSetAs[enumMember]("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			EnumSetAs[enumMember]("FERRITE_ENUM_SET", "").Optional()
+		}).To(PanicWith("specification for FERRITE_ENUM_SET is invalid: variable description must not be empty"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is not empty", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the selected members",
+					func(value string, expect ...enumMember) {
+						os.Setenv("FERRITE_ENUM_SET", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v.Members()).To(Equal(expect))
+					},
+					Entry("single member", "<member-1>", member1),
+					Entry("multiple members", "<member-0>,<member-2>", member0, member2),
+					Entry("members in a different order", "<member-2>,<member-0>", member0, member2),
+					Entry("duplicate members", "<member-1>,<member-1>", member1),
+					Entry("whitespace around members", " <member-0> , <member-1> ", member0, member1),
+					Entry("all keyword", "all", member0, member1, member2),
+				)
+
+				It("returns an empty set when the none keyword is used", func() {
+					os.Setenv("FERRITE_ENUM_SET", "none")
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v.Len()).To(Equal(0))
+					Expect(v.Has(member0)).To(BeFalse())
+				})
+			})
+
+			Describe("func MemberSet.Has()", func() {
+				It("returns true only for selected members", func() {
+					os.Setenv("FERRITE_ENUM_SET", "<member-0>,<member-2>")
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v.Has(member0)).To(BeTrue())
+					Expect(v.Has(member1)).To(BeFalse())
+					Expect(v.Has(member2)).To(BeTrue())
+				})
+			})
+		})
+
+		When("the value is invalid", func() {
+			DescribeTable(
+				"it panics",
+				func(value, expect string) {
+					os.Setenv("FERRITE_ENUM_SET", value)
+
+					Expect(func() {
+						builder.
+							Required().
+							Value()
+					}).To(PanicWith(expect))
+				},
+				Entry(
+					"unrecognized member",
+					"<member-0>,<unrelated>",
+					`value of FERRITE_ENUM_SET ('<member-0>,<unrelated>') is invalid: member 2 ('<unrelated>') is invalid: expected '<member-0>', '<member-1>' or '<member-2>'`,
+				),
+				Entry(
+					"near miss",
+					"<membr-1>",
+					`value of FERRITE_ENUM_SET ('<membr-1>') is invalid: member 1 ('<membr-1>') is invalid: did you mean '<member-1>'?`,
+				),
+				Entry(
+					"empty member",
+					"<member-0>,,<member-1>",
+					`value of FERRITE_ENUM_SET ('<member-0>,,<member-1>') is invalid: member 2 is empty`,
+				),
+			)
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(member2, member0).
+							Required().
+							Value()
+
+						Expect(v.Members()).To(Equal([]enumMember{member0, member2}))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_ENUM_SET is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("there is a custom separator", func() {
+		It("splits the value using the separator", func() {
+			os.Setenv("FERRITE_ENUM_SET", "<member-0>|<member-1>")
+
+			v := builder.
+				WithSeparator("|").
+				Required().
+				Value()
+
+			Expect(v.Members()).To(Equal([]enumMember{member0, member1}))
+		})
+
+		It("panics if the separator is empty", func() {
+			Expect(func() {
+				builder.
+					WithSeparator("").
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_ENUM_SET is invalid: separator must not be empty`,
+			))
+		})
+
+		It("panics if a member contains the separator", func() {
+			Expect(func() {
+				builder.
+					WithSeparator("-").
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_ENUM_SET is invalid: "<member-0>" contains the separator ("-") and can not be used as a member`,
+			))
+		})
+	})
+
+	When("there are selection limits", func() {
+		DescribeTable(
+			"it panics if the number of selected members is out of range",
+			func(value, expect string) {
+				os.Setenv("FERRITE_ENUM_SET", value)
+
+				Expect(func() {
+					builder.
+						WithMinimumSelections(1).
+						WithMaximumSelections(2).
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"too few",
+				"none",
+				`value of FERRITE_ENUM_SET (none) is invalid: expected at least 1 member, got 0`,
+			),
+			Entry(
+				"too many",
+				"all",
+				`value of FERRITE_ENUM_SET (all) is invalid: expected at most 2 members, got 3`,
+			),
+		)
+
+		It("panics if the default value is out of range", func() {
+			Expect(func() {
+				builder.
+					WithMinimumSelections(2).
+					WithDefault(member1).
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_ENUM_SET is invalid: default value: expected at least 2 members, got 1`,
+			))
+		})
+
+		It("panics if the minimum is greater than the maximum", func() {
+			Expect(func() {
+				builder.
+					WithMinimumSelections(2).
+					WithMaximumSelections(1).
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_ENUM_SET is invalid: minimum selections (2) must not be greater than maximum selections (1)`,
+			))
+		})
+
+		It("panics if the minimum is greater than the number of members", func() {
+			Expect(func() {
+				builder.
+					WithMinimumSelections(4).
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_ENUM_SET is invalid: minimum selections (4) must not be greater than the number of members (3)`,
+			))
+		})
+
+		It("panics if a limit is negative", func() {
+			Expect(func() {
+				builder.
+					WithMaximumSelections(-1).
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_ENUM_SET is invalid: maximum selections must not be negative`,
+			))
+		})
+	})
+
+	When("a member uses a reserved keyword", func() {
+		It("panics", func() {
+			Expect(func() {
+				EnumSet("FERRITE_ENUM_SET", "<desc>").
+					WithMembers("some", "all").
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_ENUM_SET is invalid: "all" is a reserved keyword and can not be used as a member`,
+			))
+		})
+	})
+
+	When("the default value includes a value that is not a member", func() {
+		It("panics", func() {
+			Expect(func() {
+				builder.
+					WithDefault(member0, enumMember(100)).
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_ENUM_SET is invalid: default value: expected '<member-0>', '<member-1>' or '<member-2>'`,
+			))
+		})
+	})
+})
+
+func ExampleEnumSet_required() {
+	defer example()()
+
+	v := ferrite.
+		EnumSet("FERRITE_ENUM_SET", "example enum set variable").
+		WithMembers("search", "billing", "exports").
+		Required()
+
+	os.Setenv("FERRITE_ENUM_SET", "exports,search")
+	ferrite.Init()
+
+	fmt.Println("members are", v.Value().Members())
+	fmt.Println("billing enabled:", v.Value().Has("billing"))
+
+	// Output:
+	// members are [search exports]
+	// billing enabled: false
+}
+
+func ExampleEnumSet_default() {
+	defer example()()
+
+	v := ferrite.
+		EnumSet("FERRITE_ENUM_SET", "example enum set variable").
+		WithMembers("search", "billing", "exports").
+		WithDefault("search").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("members are", v.Value().Members())
+
+	// Output:
+	// members are [search]
+}
+
+func ExampleEnumSet_optional() {
+	defer example()()
+
+	v := ferrite.
+		EnumSet("FERRITE_ENUM_SET", "example enum set variable").
+		WithMembers("search", "billing", "exports").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("members are", x.Members())
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleEnumSet_deprecated() {
+	defer example()()
+
+	v := ferrite.
+		EnumSet("FERRITE_ENUM_SET", "example enum set variable").
+		WithMembers("search", "billing", "exports").
+		Deprecated()
+
+	os.Setenv("FERRITE_ENUM_SET", "all")
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("members are", x.Members())
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_ENUM_SET  example enum set variable  [ <string> ]  ⚠ deprecated variable set to all, equivalent to search,billing,exports
+	//
+	// members are [search billing exports]
+}
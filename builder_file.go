@@ -42,6 +42,39 @@ func (b *FileBuilder) WithDefault(v string) *FileBuilder {
 	return b
 }
 
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *FileBuilder) WithDefaultFunc(
+	fn func() (v string, ok bool),
+	from ...AnyVariableSet,
+) *FileBuilder {
+	b.builder.DerivedDefault(
+		func() (FileName, bool) {
+			v, ok := fn()
+			return FileName(v), ok
+		},
+		specsOf(from)...,
+	)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *FileBuilder) WithDefaultIn(p *Profile, v string) *FileBuilder {
+	b.builder.ProfileDefault(p.profile, FileName(v))
+	return b
+}
+
 // WithExample adds an example value to the variable's documentation.
 func (b *FileBuilder) WithExample(v string, desc string) *FileBuilder {
 	b.builder.NormativeExample(FileName(v), desc)
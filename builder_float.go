@@ -1,10 +1,10 @@
 package ferrite
 
 import (
-	"errors"
 	"math"
 	"strconv"
 
+	"github.com/dogmatiq/ferrite/internal/inflect"
 	"github.com/dogmatiq/ferrite/internal/maybe"
 	"github.com/dogmatiq/ferrite/internal/reflectx"
 	"github.com/dogmatiq/ferrite/internal/variable"
@@ -24,47 +24,15 @@ func Float[T constraints.Float](name, desc string) *FloatBuilder[T] {
 
 	b.builder.Name(name)
 	b.builder.Description(desc)
-	b.builder.BuiltInConstraint(
-		"must be a finite number",
-		func(_ variable.ConstraintContext, v T) variable.ConstraintError {
-			v64 := float64(v)
-			if math.IsNaN(v64) || math.IsInf(v64, 0) {
-				return errors.New("expected a finite number")
-			}
-			return nil
-		},
-	)
-	b.builder.Documentation().
-		Summary("Floating-point syntax").
-		Paragraph(
-			"Floating-point values can be specified using decimal (base-10) or hexadecimal (base-16) notation, and may use scientific notation.",
-			"A leading positive sign (`+`) is **OPTIONAL**.",
-			"A leading negative sign (`-`) is **REQUIRED** in order to specify a negative value.",
-		).
-		Format().
-		Paragraph(
-			"Internally, the `%s` variable is represented using a %d-bit floating point type (`%s`);",
-			"any value that overflows this data-type is invalid.",
-			"Values are rounded to the nearest floating-point number using IEEE 754 unbiased rounding.",
-		).
-		Format(
-			name,
-			reflectx.BitSize[T](),
-			reflectx.KindOf[T](),
-		).
-		Paragraph(
-			"The non-finite values `NaN`, `+Inf` and `-Inf` are not accepted.",
-		).
-		Format().
-		Done()
 
 	return b
 }
 
 // FloatBuilder builds a specification for a floating-point number.
 type FloatBuilder[T constraints.Float] struct {
-	schema  variable.TypedNumeric[T]
-	builder variable.TypedSpecBuilder[T]
+	schema    variable.TypedNumeric[T]
+	builder   variable.TypedSpecBuilder[T]
+	marshaler floatMarshaler[T]
 }
 
 var _ isBuilderOf[
@@ -73,6 +41,8 @@ var _ isBuilderOf[
 	*FloatBuilder[float32],
 ]
 
+var _ isFinalizedBuilder[*FloatBuilder[float32]]
+
 // WithDefault sets the default value of the variable.
 //
 // It is used when the environment variable is undefined or empty.
@@ -81,6 +51,33 @@ func (b *FloatBuilder[T]) WithDefault(v T) *FloatBuilder[T] {
 	return b
 }
 
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *FloatBuilder[T]) WithDefaultFunc(
+	fn func() (v T, ok bool),
+	from ...AnyVariableSet,
+) *FloatBuilder[T] {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *FloatBuilder[T]) WithDefaultIn(p *Profile, v T) *FloatBuilder[T] {
+	b.builder.ProfileDefault(p.profile, v)
+	return b
+}
+
 // WithExample adds an example value to the variable's documentation.
 func (b *FloatBuilder[T]) WithExample(v T, desc string) *FloatBuilder[T] {
 	b.builder.NormativeExample(v, desc)
@@ -99,35 +96,107 @@ func (b *FloatBuilder[T]) WithMaximum(v T) *FloatBuilder[T] {
 	return b
 }
 
+// WithPrecision rounds the value to the given number of digits after the
+// decimal point.
+//
+// The value is rounded when it is parsed, and is always rendered with exactly
+// the given number of digits after the decimal point.
+func (b *FloatBuilder[T]) WithPrecision(digits int) *FloatBuilder[T] {
+	if digits < 0 {
+		panic("precision must not be negative")
+	}
+
+	b.marshaler.precision = maybe.Some(digits)
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *FloatBuilder[T]) Required(options ...RequiredOption) Required[T] {
+	b.finalize()
 	return required(b.schema, &b.builder, options...)
 }
 
 // Optional completes the build process and registers an optional variable with
 // Ferrite's validation system.
 func (b *FloatBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
+	b.finalize()
 	return optional(b.schema, &b.builder, options...)
 }
 
 // Deprecated completes the build process and registers a deprecated variable
 // with Ferrite's validation system.
 func (b *FloatBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
+	b.finalize()
 	return deprecated(b.schema, &b.builder, options...)
 }
 
-type floatMarshaler[T constraints.Float] struct{}
+// finalize documents the floating-point syntax and the options that affect it.
+func (b *FloatBuilder[T]) finalize() {
+	b.schema.Marshaler = b.marshaler
+
+	doc := b.builder.Documentation().
+		Summary("Floating-point syntax").
+		Paragraph(
+			"Floating-point values can be specified using decimal (base-10) or hexadecimal (base-16) notation, and may use scientific notation.",
+			"A leading positive sign (`+`) is **OPTIONAL**.",
+			"A leading negative sign (`-`) is **REQUIRED** in order to specify a negative value.",
+		).
+		Format().
+		Paragraph(
+			"Internally, the `%s` variable is represented using a %d-bit floating point type (`%s`);",
+			"any value that overflows this data-type is invalid.",
+			"Values are rounded to the nearest floating-point number using IEEE 754 unbiased rounding.",
+		).
+		Format(
+			b.builder.Peek().Name(),
+			reflectx.BitSize[T](),
+			reflectx.KindOf[T](),
+		)
+
+	if p, ok := b.marshaler.precision.Get(); ok {
+		doc = doc.
+			Paragraph(
+				"Values are then rounded to %d %s after the decimal point.",
+			).
+			Format(p, inflect.Pluralize("digit", p))
+	}
+
+	doc.
+		Paragraph(
+			"The non-finite values `NaN`, `+Inf` and `-Inf` are not accepted.",
+		).
+		Format().
+		Done()
+}
+
+type floatMarshaler[T constraints.Float] struct {
+	precision maybe.Value[int]
+}
+
+func (m floatMarshaler[T]) Marshal(v T) (variable.Literal, error) {
+	if p, ok := m.precision.Get(); ok {
+		return variable.Literal{
+			String: formatFloatWithPrecision(v, p),
+		}, nil
+	}
 
-func (floatMarshaler[T]) Marshal(v T) (variable.Literal, error) {
 	return variable.Literal{
 		String: formatFloat(v),
 	}, nil
 }
 
-func (floatMarshaler[T]) Unmarshal(v variable.Literal) (T, error) {
+func (m floatMarshaler[T]) Unmarshal(v variable.Literal) (T, error) {
 	n, err := strconv.ParseFloat(v.String, reflectx.BitSize[T]())
-	return T(n), variable.UnwrapNumericParseError(err, formatFloat[T])
+	if err != nil {
+		return 0, variable.UnwrapNumericParseError(err, formatFloat[T])
+	}
+
+	if p, ok := m.precision.Get(); ok {
+		n = roundFloat(n, p)
+	}
+
+	return T(n), nil
 }
 
 func formatFloat[T constraints.Float](v T) string {
@@ -146,3 +215,38 @@ func formatFloat[T constraints.Float](v T) string {
 
 	return s
 }
+
+func formatFloatWithPrecision[T constraints.Float](v T, digits int) string {
+	s := strconv.FormatFloat(
+		roundFloat(float64(v), digits),
+		'f',
+		digits,
+		reflectx.BitSize[T](),
+	)
+
+	switch s[0] {
+	case '+', '-':
+	default:
+		s = "+" + s
+	}
+
+	return s
+}
+
+// roundFloat rounds v to the given number of digits after the decimal point.
+func roundFloat(v float64, digits int) float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return v
+	}
+
+	scale := math.Pow10(digits)
+	r := math.Round(v*scale) / scale
+
+	// If scaling overflows the value is already too large to have a
+	// fractional part worth rounding.
+	if math.IsInf(r, 0) || math.IsNaN(r) {
+		return v
+	}
+
+	return r
+}
@@ -260,6 +260,40 @@ var _ = Describe("type FloatBuilder", func() {
 			))
 		})
 	})
+
+	When("the value is not finite", func() {
+		It("panics", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_FLOAT", "+Inf")
+
+				builder.
+					WithMinimum(0).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_FLOAT (+Inf) is invalid: expected a finite number`,
+			))
+		})
+	})
+
+	When("there is a precision", func() {
+		It("rounds the value", func() {
+			os.Setenv("FERRITE_FLOAT", "1.23456")
+
+			v := builder.
+				WithPrecision(2).
+				Required().
+				Value()
+
+			Expect(v).To(BeNumerically("~", 1.23, 0.0001))
+		})
+
+		It("panics if the precision is negative", func() {
+			Expect(func() {
+				builder.WithPrecision(-1)
+			}).To(PanicWith("precision must not be negative"))
+		})
+	})
 })
 
 func ExampleFloat_required() {
@@ -331,6 +365,23 @@ func ExampleFloat_limits() {
 	// value is -2
 }
 
+func ExampleFloat_precision() {
+	defer example()()
+
+	v := ferrite.
+		Float[float64]("FERRITE_FLOAT", "example floating-point variable").
+		WithPrecision(2).
+		Required()
+
+	os.Setenv("FERRITE_FLOAT", "3.14159")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 3.14
+}
+
 func ExampleFloat_deprecated() {
 	defer example()()
 
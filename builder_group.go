@@ -0,0 +1,291 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// Group configures a set of environment variables that are combined into a
+// single value of type T.
+//
+// Each variable in the group is declared using one of the existing builders
+// and added to the group using [Member]. The members' values are combined by
+// the function passed to [GroupBuilder.WithConstructor].
+//
+// Options passed to the group's Required(), Optional() or Deprecated() method,
+// such as [WithRegistry] and [RelevantIf], apply to every member.
+//
+// desc is a human-readable description of the value produced by the group.
+func Group[T any](desc string) *GroupBuilder[T] {
+	if desc == "" {
+		panic("invalid specification: group description must not be empty")
+	}
+
+	return &GroupBuilder[T]{
+		desc: desc,
+	}
+}
+
+// GroupBuilder builds a specification for a group of variables that are
+// combined into a single value.
+type GroupBuilder[T any] struct {
+	desc      string
+	members   []groupMember
+	construct func() (T, error)
+}
+
+var _ isBuilderOfMinimal[
+	any,
+	*GroupBuilder[any],
+]
+
+// WithConstructor sets the function that combines the values of the group's
+// members into a single value.
+//
+// fn is called each time the group's value is obtained, after all of the
+// members have been resolved. It obtains each member's value by calling
+// [GroupMember.Value]. If fn returns an error the group's value is considered
+// invalid.
+func (g *GroupBuilder[T]) WithConstructor(fn func() (T, error)) *GroupBuilder[T] {
+	g.construct = fn
+	return g
+}
+
+// Required completes the build process and registers the group's members as
+// required variables with Ferrite's validation system.
+func (g *GroupBuilder[T]) Required(options ...RequiredOption) Required[T] {
+	options = append(options[:len(options):len(options)], g.documentation())
+	g.register(func(m groupMember) { m.registerRequired(options) })
+
+	return requiredFunc[T]{
+		g.variables(),
+		func() (T, error) {
+			v, _, err := g.resolve()
+			return v, err
+		},
+		g.literals,
+	}
+}
+
+// Optional completes the build process and registers the group's members as
+// optional variables with Ferrite's validation system.
+//
+// The group's value is only available if all of its members are available. It
+// is an error to define some, but not all, of the members.
+func (g *GroupBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
+	options = append(options[:len(options):len(options)], g.documentation())
+	g.register(func(m groupMember) { m.registerOptional(options) })
+
+	return optionalFunc[T]{
+		g.variables(),
+		g.resolve,
+		g.literals,
+	}
+}
+
+// Deprecated completes the build process and registers the group's members as
+// deprecated variables with Ferrite's validation system.
+func (g *GroupBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
+	options = append(options[:len(options):len(options)], g.documentation())
+	g.register(func(m groupMember) { m.registerDeprecated(options) })
+
+	return deprecatedFunc[T]{
+		g.variables(),
+		g.resolve,
+		g.literals,
+	}
+}
+
+// register registers each of the group's members using fn, then establishes
+// relationships between the variables of each member.
+func (g *GroupBuilder[T]) register(fn func(groupMember)) {
+	if len(g.members) == 0 {
+		panic(fmt.Sprintf(
+			"specification for %q group is invalid: group must have at least one member",
+			g.desc,
+		))
+	}
+
+	if g.construct == nil {
+		panic(fmt.Sprintf(
+			"specification for %q group is invalid: group must have a constructor",
+			g.desc,
+		))
+	}
+
+	for _, m := range g.members {
+		fn(m)
+	}
+
+	var relationships []variable.Relationship
+
+	for i, m := range g.members {
+		for j, other := range g.members {
+			if i == j {
+				continue
+			}
+
+			for _, subject := range m.variables() {
+				for _, object := range other.variables() {
+					relationships = append(
+						relationships,
+						variable.RefersTo{
+							Subject:  subject.Spec(),
+							RefersTo: object.Spec(),
+						},
+					)
+				}
+			}
+		}
+	}
+
+	variable.EstablishRelationships(relationships...)
+}
+
+// documentation returns an option that adds documentation about the group to
+// each of its members.
+func (g *GroupBuilder[T]) documentation() option {
+	return option{
+		ApplyToSpec: func(b variable.SpecBuilder) {
+			b.Documentation().
+				Paragraph(
+					"This variable is one of a group of variables that together specify %s.",
+				).
+				Format(g.desc).
+				Done()
+		},
+		ApplyToSpecInOptionalSet: func(b variable.SpecBuilder) {
+			b.Documentation().
+				Paragraph(
+					"If any variable in the group is defined,",
+					"the others **MUST** also be defined, unless they have a default value.",
+				).
+				Format().
+				Done()
+		},
+	}
+}
+
+// variables returns the variables of each of the group's members.
+func (g *GroupBuilder[T]) variables() []variable.Any {
+	var vars []variable.Any
+	for _, m := range g.members {
+		vars = append(vars, m.variables()...)
+	}
+	return vars
+}
+
+// resolve builds the group's value from the values of its members.
+func (g *GroupBuilder[T]) resolve() (T, bool, error) {
+	var zero T
+
+	vars := g.variables()
+	explicit := false
+	available := true
+
+	for _, v := range vars {
+		if err := v.Error(); err != nil {
+			return zero, false, err
+		}
+
+		if v.Source() == variable.SourceEnvironment {
+			explicit = true
+		}
+
+		if v.Availability() != variable.AvailabilityOK {
+			available = false
+		}
+	}
+
+	if !available {
+		if explicit {
+			if err := checkDefinedTogether(vars...); err != nil {
+				return zero, false, err
+			}
+		}
+		return zero, false, nil
+	}
+
+	v, err := g.construct()
+	if err != nil {
+		return zero, false, err
+	}
+
+	return v, true, nil
+}
+
+// literals returns an error, as the group's value can not be decomposed into
+// the values of its members.
+func (g *GroupBuilder[T]) literals(T) ([]variable.Literal, error) {
+	return nil, errors.New("the value of a group can not be represented as environment variable values")
+}
+
+// Member adds a variable, declared using builder b, to the group g.
+//
+// It returns a [GroupMember] that is used within the group's constructor to
+// obtain the member's value. b must not be used after it is added to the group.
+func Member[T, G any](
+	g *GroupBuilder[G],
+	b interface {
+		Required(...RequiredOption) Required[T]
+		Optional(...OptionalOption) Optional[T]
+		Deprecated(...DeprecatedOption) Deprecated[T]
+	},
+) *GroupMember[T] {
+	m := &GroupMember[T]{
+		builder: b,
+	}
+
+	g.members = append(g.members, m)
+
+	return m
+}
+
+// GroupMember is a member of a group of variables, as returned by [Member].
+type GroupMember[T any] struct {
+	builder interface {
+		Required(...RequiredOption) Required[T]
+		Optional(...OptionalOption) Optional[T]
+		Deprecated(...DeprecatedOption) Deprecated[T]
+	}
+	set VariableSet[T]
+}
+
+// Value returns the member's value.
+//
+// It is intended to be called from within the group's constructor, at which
+// point the member is guaranteed to be available.
+func (m *GroupMember[T]) Value() T {
+	if m.set == nil {
+		panic("group member has not been registered")
+	}
+
+	v, _ := m.set.native()
+	return v
+}
+
+func (m *GroupMember[T]) registerRequired(options []RequiredOption) {
+	m.set = m.builder.Required(options...)
+}
+
+func (m *GroupMember[T]) registerOptional(options []OptionalOption) {
+	m.set = m.builder.Optional(options...)
+}
+
+func (m *GroupMember[T]) registerDeprecated(options []DeprecatedOption) {
+	m.set = m.builder.Deprecated(options...)
+}
+
+func (m *GroupMember[T]) variables() []variable.Any {
+	return m.set.variables()
+}
+
+// groupMember is the non-generic interface of [GroupMember].
+type groupMember interface {
+	registerRequired([]RequiredOption)
+	registerOptional([]OptionalOption)
+	registerDeprecated([]DeprecatedOption)
+	variables() []variable.Any
+}
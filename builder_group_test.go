@@ -0,0 +1,315 @@
+package ferrite_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type groupTestServer struct {
+	Host    string
+	Port    string
+	Timeout time.Duration
+}
+
+var _ = Describe("type GroupBuilder", func() {
+	var (
+		builder *GroupBuilder[groupTestServer]
+		host    *GroupMember[string]
+		port    *GroupMember[string]
+		timeout *GroupMember[time.Duration]
+	)
+
+	BeforeEach(func() {
+		builder = Group[groupTestServer]("the upstream server")
+		host = Member(builder, String("FERRITE_GROUP_HOST", "upstream host"))
+		port = Member(builder, NetworkPort("FERRITE_GROUP_PORT", "upstream port"))
+		timeout = Member(builder, Duration("FERRITE_GROUP_TIMEOUT", "upstream timeout").WithDefault(5*time.Second))
+
+		builder.WithConstructor(
+			func() (groupTestServer, error) {
+				return groupTestServer{
+					host.Value(),
+					port.Value(),
+					timeout.Value(),
+				}, nil
+			},
+		)
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Group[groupTestServer]("")
+		}).To(PanicWith("invalid specification: group description must not be empty"))
+	})
+
+	It("panics if the group has no members", func() {
+		Expect(func() {
+			Group[groupTestServer]("<desc>").
+				WithConstructor(
+					func() (groupTestServer, error) {
+						return groupTestServer{}, nil
+					},
+				).
+				Optional()
+		}).To(PanicWith(`specification for "<desc>" group is invalid: group must have at least one member`))
+	})
+
+	It("panics if the group has no constructor", func() {
+		Expect(func() {
+			g := Group[groupTestServer]("<desc>")
+			Member(g, String("FERRITE_GROUP_HOST", "upstream host"))
+			g.Optional()
+		}).To(PanicWith(`specification for "<desc>" group is invalid: group must have a constructor`))
+	})
+
+	When("the group is required", func() {
+		When("all of the members are defined", func() {
+			BeforeEach(func() {
+				os.Setenv("FERRITE_GROUP_HOST", "host.example.org")
+				os.Setenv("FERRITE_GROUP_PORT", "8080")
+			})
+
+			Describe("func Value()", func() {
+				It("returns the constructed value", func() {
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v).To(Equal(
+						groupTestServer{
+							Host:    "host.example.org",
+							Port:    "8080",
+							Timeout: 5 * time.Second,
+						},
+					))
+				})
+			})
+		})
+
+		When("one of the members is undefined", func() {
+			BeforeEach(func() {
+				os.Setenv("FERRITE_GROUP_HOST", "host.example.org")
+			})
+
+			Describe("func Value()", func() {
+				It("panics", func() {
+					Expect(func() {
+						builder.
+							Required().
+							Value()
+					}).To(PanicWith("FERRITE_GROUP_PORT is undefined and does not have a default value"))
+				})
+			})
+		})
+
+		When("the constructor returns an error", func() {
+			BeforeEach(func() {
+				os.Setenv("FERRITE_GROUP_HOST", "host.example.org")
+				os.Setenv("FERRITE_GROUP_PORT", "8080")
+
+				builder.WithConstructor(
+					func() (groupTestServer, error) {
+						return groupTestServer{}, errors.New("<error>")
+					},
+				)
+			})
+
+			Describe("func Value()", func() {
+				It("panics", func() {
+					Expect(func() {
+						builder.
+							Required().
+							Value()
+					}).To(PanicWith("<error>"))
+				})
+			})
+		})
+	})
+
+	When("the group is optional", func() {
+		When("all of the members are defined", func() {
+			BeforeEach(func() {
+				os.Setenv("FERRITE_GROUP_HOST", "host.example.org")
+				os.Setenv("FERRITE_GROUP_PORT", "8080")
+				os.Setenv("FERRITE_GROUP_TIMEOUT", "10s")
+			})
+
+			Describe("func Value()", func() {
+				It("returns the constructed value", func() {
+					v, ok := builder.
+						Optional().
+						Value()
+
+					Expect(ok).To(BeTrue())
+					Expect(v).To(Equal(
+						groupTestServer{
+							Host:    "host.example.org",
+							Port:    "8080",
+							Timeout: 10 * time.Second,
+						},
+					))
+				})
+			})
+		})
+
+		When("none of the members are defined", func() {
+			Describe("func Value()", func() {
+				It("returns with ok == false", func() {
+					_, ok := builder.
+						Optional().
+						Value()
+
+					Expect(ok).To(BeFalse())
+				})
+			})
+		})
+
+		When("some of the members are defined", func() {
+			BeforeEach(func() {
+				os.Setenv("FERRITE_GROUP_HOST", "host.example.org")
+			})
+
+			Describe("func Value()", func() {
+				It("panics", func() {
+					Expect(func() {
+						builder.
+							Optional().
+							Value()
+					}).To(PanicWith("FERRITE_GROUP_HOST and FERRITE_GROUP_TIMEOUT are defined but FERRITE_GROUP_PORT is not, define all or none"))
+				})
+			})
+		})
+	})
+
+	When("the group is relevant only under some condition", func() {
+		var enabled Required[bool]
+
+		BeforeEach(func() {
+			enabled = Bool("FERRITE_GROUP_ENABLED", "enable the upstream server").
+				WithDefault(false).
+				Required()
+
+			os.Setenv("FERRITE_GROUP_HOST", "host.example.org")
+		})
+
+		It("applies the condition to every member", func() {
+			_, ok := builder.
+				Optional(RelevantIf(enabled)).
+				Value()
+
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
+
+func ExampleGroup() {
+	defer example()()
+
+	type Upstream struct {
+		Host string
+		Port string
+	}
+
+	g := ferrite.Group[Upstream]("the upstream server")
+	host := ferrite.Member(g, ferrite.String("FERRITE_UPSTREAM_HOST", "upstream host"))
+	port := ferrite.Member(g, ferrite.NetworkPort("FERRITE_UPSTREAM_PORT", "upstream port"))
+
+	v := g.
+		WithConstructor(
+			func() (Upstream, error) {
+				return Upstream{host.Value(), port.Value()}, nil
+			},
+		).
+		Required()
+
+	os.Setenv("FERRITE_UPSTREAM_HOST", "host.example.org")
+	os.Setenv("FERRITE_UPSTREAM_PORT", "8080")
+	ferrite.Init()
+
+	fmt.Printf("value is %+v\n", v.Value())
+
+	// Output:
+	// value is {Host:host.example.org Port:8080}
+}
+
+func ExampleGroup_optional() {
+	defer example()()
+
+	type Upstream struct {
+		Host string
+		Port string
+	}
+
+	g := ferrite.Group[Upstream]("the upstream server")
+	host := ferrite.Member(g, ferrite.String("FERRITE_UPSTREAM_HOST", "upstream host"))
+	port := ferrite.Member(g, ferrite.NetworkPort("FERRITE_UPSTREAM_PORT", "upstream port"))
+
+	v := g.
+		WithConstructor(
+			func() (Upstream, error) {
+				return Upstream{host.Value(), port.Value()}, nil
+			},
+		).
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Printf("value is %+v\n", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleGroup_deprecated() {
+	defer example()()
+
+	type Upstream struct {
+		Host string
+		Port string
+	}
+
+	g := ferrite.Group[Upstream]("the upstream server")
+	host := ferrite.Member(g, ferrite.String("FERRITE_UPSTREAM_HOST", "upstream host"))
+	port := ferrite.Member(g, ferrite.NetworkPort("FERRITE_UPSTREAM_PORT", "upstream port"))
+
+	v := g.
+		WithConstructor(
+			func() (Upstream, error) {
+				return Upstream{host.Value(), port.Value()}, nil
+			},
+		).
+		Deprecated()
+
+	os.Setenv("FERRITE_UPSTREAM_HOST", "host.example.org")
+	os.Setenv("FERRITE_UPSTREAM_PORT", "8080")
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Printf("value is %+v\n", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_UPSTREAM_HOST  upstream host  [ <string> ]  ⚠ deprecated variable set to host.example.org
+	//  ❯ FERRITE_UPSTREAM_PORT  upstream port  [ <string> ]  ⚠ deprecated variable set to 8080
+	//
+	// value is {Host:host.example.org Port:8080}
+}
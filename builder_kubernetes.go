@@ -163,6 +163,52 @@ func (b *KubernetesServiceBuilder) WithDefault(host, port string) *KubernetesSer
 	return b
 }
 
+// WithDefaultFunc sets a function that computes the default values of the
+// variables.
+//
+// fn is called each time the variables are resolved while the environment
+// variables are undefined or empty. It returns both the host and the port,
+// which are used as the default values of their respective variables. If ok is
+// false the variables are treated as though they have no default value. from is
+// the list of variable sets that the default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *KubernetesServiceBuilder) WithDefaultFunc(
+	fn func() (v KubernetesAddress, ok bool),
+	from ...AnyVariableSet,
+) *KubernetesServiceBuilder {
+	specs := specsOf(from)
+
+	b.hostBuilder.DerivedDefault(
+		func() (string, bool) {
+			addr, ok := fn()
+			return addr.Host, ok
+		},
+		specs...,
+	)
+
+	b.portBuilder.DerivedDefault(
+		func() (string, bool) {
+			addr, ok := fn()
+			return addr.Port, ok
+		},
+		specs...,
+	)
+
+	return b
+}
+
+// WithDefaultIn sets the default values of the variables that are used when
+// the profile p is active.
+//
+// It takes precedence over the default values set by WithDefault() or
+// WithDefaultFunc().
+func (b *KubernetesServiceBuilder) WithDefaultIn(p *Profile, host, port string) *KubernetesServiceBuilder {
+	b.hostBuilder.ProfileDefault(p.profile, host)
+	b.portBuilder.ProfileDefault(p.profile, port)
+	return b
+}
+
 // Required completes the build process and registers required variables with
 // Ferrite's validation system.
 func (b *KubernetesServiceBuilder) Required(options ...RequiredOption) Required[KubernetesAddress] {
@@ -273,25 +319,14 @@ func (b *KubernetesServiceBuilder) optionalResolver(
 			return KubernetesAddress{}, false, err
 		}
 
-		availability := host.Availability()
-
-		if port.Availability() != availability {
-			def, undef := host, port
-			if availability != variable.AvailabilityOK {
-				def, undef = undef, def
-			}
-
-			return KubernetesAddress{}, false, fmt.Errorf(
-				"%s is defined but %s is not, define both or neither",
-				def.Spec().Name(),
-				undef.Spec().Name(),
-			)
+		if err := checkDefinedTogether(host, port); err != nil {
+			return KubernetesAddress{}, false, err
 		}
 
 		return KubernetesAddress{
 			host.NativeValue(),
 			port.NativeValue(),
-		}, availability == variable.AvailabilityOK, nil
+		}, host.Availability() == variable.AvailabilityOK, nil
 	}
 }
 
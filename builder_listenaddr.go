@@ -0,0 +1,387 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/maybe"
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// ListenAddr is an address on which a server listens for connections, as
+// produced by a [ListenAddress] variable.
+type ListenAddr struct {
+	network    string
+	address    string
+	socketMode maybe.Value[fs.FileMode]
+}
+
+// Network returns the name of the network, suitable for use with
+// [net.Listen]. It is one of "tcp", "tcp4", "tcp6" or "unix".
+func (a ListenAddr) Network() string {
+	return a.network
+}
+
+// Address returns the address, suitable for use with [net.Listen].
+//
+// For TCP networks it is in host:port form. For Unix sockets it is the path to
+// the socket file, or the name of an abstract socket prefixed with "@".
+func (a ListenAddr) Address() string {
+	return a.address
+}
+
+// IsUnixSocket returns true if the address is a Unix domain socket.
+func (a ListenAddr) IsUnixSocket() bool {
+	return a.network == "unix"
+}
+
+// SocketMode returns the file permissions to apply to the socket file, if
+// any.
+//
+// ok is false if the address is not a Unix socket file, or no permissions were
+// configured by [ListenAddressBuilder.WithSocketPermissions].
+func (a ListenAddr) SocketMode() (mode fs.FileMode, ok bool) {
+	if a.network != "unix" || isAbstractSocket(a.address) {
+		return 0, false
+	}
+	return a.socketMode.Get()
+}
+
+// String returns the canonical representation of the address.
+func (a ListenAddr) String() string {
+	switch a.network {
+	case "tcp":
+		return a.address
+	case "unix":
+		if isAbstractSocket(a.address) {
+			return "unix:" + a.address
+		}
+		return "unix://" + a.address
+	default:
+		return a.network + "://" + a.address
+	}
+}
+
+// Listen announces on the address using [net.Listen].
+//
+// If the address is a Unix socket file with configured permissions, the
+// permissions are applied to the socket file before returning.
+func (a ListenAddr) Listen() (net.Listener, error) {
+	l, err := net.Listen(a.network, a.address)
+	if err != nil {
+		return nil, err
+	}
+
+	if mode, ok := a.SocketMode(); ok {
+		if err := os.Chmod(a.address, mode); err != nil {
+			l.Close()
+			return nil, err
+		}
+	}
+
+	return l, nil
+}
+
+// ListenAddress configures an environment variable as an address on which a
+// server listens for connections.
+//
+// The value may be a TCP address in host:port form, such as ":8080", a TCP
+// address with an explicit network, such as "tcp6://[::1]:8080", or a Unix
+// domain socket, such as "unix:///run/app.sock" or "unix:@app".
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func ListenAddress(name, desc string) *ListenAddressBuilder {
+	b := &ListenAddressBuilder{}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.BuiltInConstraint(
+		"**MUST** be a TCP address or a Unix socket address",
+		func(_ variable.ConstraintContext, v ListenAddr) variable.ConstraintError {
+			if v.IsUnixSocket() {
+				return nil
+			}
+
+			_, port, err := net.SplitHostPort(v.address)
+			if err != nil {
+				return err
+			}
+
+			return validatePort(port)
+		},
+	)
+
+	return b
+}
+
+// ListenAddressBuilder builds a specification for a listen address variable.
+type ListenAddressBuilder struct {
+	schema      listenAddrSchema
+	builder     variable.TypedSpecBuilder[ListenAddr]
+	def         maybe.Value[string]
+	profileDefs []profileDefault[string]
+	examples    []variable.TypedExample[string]
+}
+
+var _ isBuilderOf[
+	ListenAddr,
+	string,
+	*ListenAddressBuilder,
+]
+
+var _ isFinalizedBuilder[*ListenAddressBuilder]
+
+// WithDefault sets the default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *ListenAddressBuilder) WithDefault(v string) *ListenAddressBuilder {
+	b.def = maybe.Some(v)
+	return b
+}
+
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *ListenAddressBuilder) WithDefaultFunc(
+	fn func() (v ListenAddr, ok bool),
+	from ...AnyVariableSet,
+) *ListenAddressBuilder {
+	b.def = maybe.None[string]()
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *ListenAddressBuilder) WithDefaultIn(p *Profile, v string) *ListenAddressBuilder {
+	b.profileDefs = append(b.profileDefs, profileDefault[string]{p, v})
+	return b
+}
+
+// WithExample adds an example value to the variable's documentation.
+func (b *ListenAddressBuilder) WithExample(v string, desc string) *ListenAddressBuilder {
+	b.examples = append(b.examples, variable.TypedExample[string]{
+		Native:      v,
+		Description: desc,
+		IsNormative: true,
+	})
+	return b
+}
+
+// WithSocketPermissions sets the file permissions that are applied to Unix
+// socket files by [ListenAddr.Listen], such as 0660.
+//
+// The permissions are not applied to abstract sockets, which do not have a
+// corresponding file.
+func (b *ListenAddressBuilder) WithSocketPermissions(mode fs.FileMode) *ListenAddressBuilder {
+	b.schema.SocketMode = maybe.Some(mode)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *ListenAddressBuilder) Required(options ...RequiredOption) Required[ListenAddr] {
+	b.finalize()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *ListenAddressBuilder) Optional(options ...OptionalOption) Optional[ListenAddr] {
+	b.finalize()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *ListenAddressBuilder) Deprecated(options ...DeprecatedOption) Deprecated[ListenAddr] {
+	b.finalize()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+// finalize documents the socket mode and adds the default value and examples.
+func (b *ListenAddressBuilder) finalize() {
+	doc := buildNetworkAddrSyntaxDocumentation(b.builder.Documentation()).
+		Paragraph(
+			"The host may be omitted to listen on all interfaces, such as `:8080`.",
+			"The address may be prefixed with `tcp://`, `tcp4://` or `tcp6://` to restrict the IP version.",
+		).
+		Format().
+		Paragraph(
+			"Unix domain sockets are specified as `unix://` followed by an absolute path, such as `%s`.",
+			"On Linux, abstract sockets are specified as `unix:@` followed by a name, such as `%s`.",
+		).
+		Format("unix:///run/app.sock", "unix:@app")
+
+	if mode, ok := b.schema.SocketMode.Get(); ok {
+		doc = doc.
+			Paragraph("Unix socket files are created with permissions `%s`.").
+			Format(fmt.Sprintf("%04o", mode.Perm()))
+	}
+
+	doc.Done()
+
+	if v, ok := b.def.Get(); ok {
+		b.builder.Default(mustParseListenAddr(b.schema, v))
+	}
+
+	for _, d := range b.profileDefs {
+		b.builder.ProfileDefault(d.profile.profile, mustParseListenAddr(b.schema, d.value))
+	}
+
+	for _, eg := range b.examples {
+		b.builder.NormativeExample(
+			mustParseListenAddr(b.schema, eg.Native),
+			eg.Description,
+		)
+	}
+}
+
+// listenAddrSchema is the schema for a [ListenAddress] variable.
+type listenAddrSchema struct {
+	SocketMode maybe.Value[fs.FileMode]
+}
+
+func (s listenAddrSchema) Type() reflect.Type {
+	return reflectx.TypeOf[ListenAddr]()
+}
+
+func (s listenAddrSchema) Finalize() error {
+	if mode, ok := s.SocketMode.Get(); ok && mode&^fs.ModePerm != 0 {
+		return fmt.Errorf("socket permissions (%s) must only contain permission bits", mode)
+	}
+	return nil
+}
+
+func (s listenAddrSchema) AcceptVisitor(v variable.SchemaVisitor) {
+	v.VisitOther(s)
+}
+
+func (s listenAddrSchema) Marshal(v ListenAddr) (variable.Literal, error) {
+	if v.network == "" {
+		return variable.Literal{}, errors.New("expected a listen address")
+	}
+	return variable.Literal{String: v.String()}, nil
+}
+
+func (s listenAddrSchema) Unmarshal(v variable.Literal) (ListenAddr, error) {
+	addr, err := parseListenAddr(v.String)
+	if err != nil {
+		return ListenAddr{}, err
+	}
+
+	addr.socketMode = s.SocketMode
+	return addr, nil
+}
+
+func (s listenAddrSchema) Examples(conservative bool) []variable.TypedExample[ListenAddr] {
+	examples := []variable.TypedExample[ListenAddr]{
+		{
+			Native:      mustParseListenAddr(s, ":8080"),
+			Description: "a TCP port on all interfaces",
+		},
+	}
+
+	if !conservative {
+		examples = append(
+			examples,
+			variable.TypedExample[ListenAddr]{
+				Native:      mustParseListenAddr(s, "127.0.0.1:8080"),
+				Description: "a TCP port on the IPv4 loopback interface",
+			},
+			variable.TypedExample[ListenAddr]{
+				Native:      mustParseListenAddr(s, "tcp6://[::1]:8080"),
+				Description: "a TCP port on the IPv6 loopback interface",
+			},
+			variable.TypedExample[ListenAddr]{
+				Native:      mustParseListenAddr(s, "unix:///run/app.sock"),
+				Description: "a Unix socket file",
+			},
+		)
+	}
+
+	return examples
+}
+
+// parseListenAddr parses a listen address in any of the supported forms.
+func parseListenAddr(s string) (ListenAddr, error) {
+	scheme, rest, ok := strings.Cut(s, ":")
+
+	switch {
+	case ok && strings.EqualFold(scheme, "unix"):
+		return parseUnixListenAddr(rest)
+	case ok && strings.HasPrefix(rest, "//"):
+		network := strings.ToLower(scheme)
+		switch network {
+		case "tcp", "tcp4", "tcp6":
+			return parseTCPListenAddr(network, rest[2:])
+		default:
+			return ListenAddr{}, fmt.Errorf(
+				"unsupported network (%s), expected tcp, tcp4, tcp6 or unix",
+				scheme,
+			)
+		}
+	default:
+		return parseTCPListenAddr("tcp", s)
+	}
+}
+
+// parseTCPListenAddr parses a TCP listen address in host:port form.
+func parseTCPListenAddr(network, s string) (ListenAddr, error) {
+	addr, err := networkAddrMarshaler{}.Unmarshal(variable.Literal{String: s})
+	if err != nil {
+		return ListenAddr{}, err
+	}
+
+	return ListenAddr{
+		network: network,
+		address: addr.String(),
+	}, nil
+}
+
+// parseUnixListenAddr parses the portion of a Unix socket address that follows
+// the "unix:" prefix.
+func parseUnixListenAddr(s string) (ListenAddr, error) {
+	if isAbstractSocket(s) {
+		if len(s) == 1 {
+			return ListenAddr{}, errors.New("abstract socket name must not be empty")
+		}
+		return ListenAddr{network: "unix", address: s}, nil
+	}
+
+	path, ok := strings.CutPrefix(s, "//")
+	if !ok || !strings.HasPrefix(path, "/") {
+		return ListenAddr{}, errors.New("expected a Unix socket address, such as unix:///run/app.sock or unix:@app")
+	}
+
+	return ListenAddr{network: "unix", address: path}, nil
+}
+
+// isAbstractSocket returns true if addr is the name of an abstract Unix socket.
+func isAbstractSocket(addr string) bool {
+	return strings.HasPrefix(addr, "@")
+}
+
+func mustParseListenAddr(s listenAddrSchema, v string) ListenAddr {
+	addr, err := s.Unmarshal(variable.Literal{String: v})
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
@@ -0,0 +1,253 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type ListenAddressBuilder", func() {
+	var builder *ListenAddressBuilder
+
+	BeforeEach(func() {
+		builder = ListenAddress("FERRITE_LISTEN_ADDR", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			ListenAddress("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			ListenAddress("FERRITE_LISTEN_ADDR", "").Optional()
+		}).To(PanicWith("specification for FERRITE_LISTEN_ADDR is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the socket permissions contain non-permission bits", func() {
+		Expect(func() {
+			builder.
+				WithSocketPermissions(fs.ModeDir | 0o755).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_LISTEN_ADDR is invalid: socket permissions (drwxr-xr-x) must only contain permission bits"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the address",
+					func(input, network, address, canonical string) {
+						os.Setenv("FERRITE_LISTEN_ADDR", input)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v.Network()).To(Equal(network))
+						Expect(v.Address()).To(Equal(address))
+						Expect(v.String()).To(Equal(canonical))
+					},
+					Entry("all interfaces", ":8080", "tcp", ":8080", ":8080"),
+					Entry("host and port", "127.0.0.1:8080", "tcp", "127.0.0.1:8080", "127.0.0.1:8080"),
+					Entry("service name", "localhost:https", "tcp", "localhost:https", "localhost:https"),
+					Entry("tcp scheme", "tcp://127.0.0.1:8080", "tcp", "127.0.0.1:8080", "127.0.0.1:8080"),
+					Entry("tcp4 scheme", "tcp4://0.0.0.0:8080", "tcp4", "0.0.0.0:8080", "tcp4://0.0.0.0:8080"),
+					Entry("tcp6 scheme", "tcp6://[::1]:8080", "tcp6", "[::1]:8080", "tcp6://[::1]:8080"),
+					Entry("uppercase scheme", "TCP6://[::1]:8080", "tcp6", "[::1]:8080", "tcp6://[::1]:8080"),
+					Entry("unix socket file", "unix:///run/app.sock", "unix", "/run/app.sock", "unix:///run/app.sock"),
+					Entry("abstract unix socket", "unix:@app", "unix", "@app", "unix:@app"),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(input, expect string) {
+						os.Setenv("FERRITE_LISTEN_ADDR", input)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"missing port",
+						"localhost",
+						`value of FERRITE_LISTEN_ADDR (localhost) is invalid: address localhost: missing port in address`,
+					),
+					Entry(
+						"invalid port",
+						":65536",
+						`value of FERRITE_LISTEN_ADDR (:65536) is invalid: numeric ports must be between 1 and 65535`,
+					),
+					Entry(
+						"unsupported network",
+						"udp://:53",
+						`value of FERRITE_LISTEN_ADDR (udp://:53) is invalid: unsupported network (udp), expected tcp, tcp4, tcp6 or unix`,
+					),
+					Entry(
+						"relative socket path",
+						"unix://run/app.sock",
+						`value of FERRITE_LISTEN_ADDR (unix://run/app.sock) is invalid: expected a Unix socket address, such as unix:///run/app.sock or unix:@app`,
+					),
+					Entry(
+						"empty abstract socket name",
+						"unix:@",
+						`value of FERRITE_LISTEN_ADDR (unix:@) is invalid: abstract socket name must not be empty`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("unix:///run/app.sock").
+							Required().
+							Value()
+
+						Expect(v.Network()).To(Equal("unix"))
+						Expect(v.Address()).To(Equal("/run/app.sock"))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_LISTEN_ADDR is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("there are socket permissions", func() {
+		BeforeEach(func() {
+			builder.WithSocketPermissions(0o660)
+		})
+
+		It("exposes the permissions of a unix socket file", func() {
+			os.Setenv("FERRITE_LISTEN_ADDR", "unix:///run/app.sock")
+
+			v := builder.
+				Required().
+				Value()
+
+			mode, ok := v.SocketMode()
+			Expect(ok).To(BeTrue())
+			Expect(mode).To(Equal(fs.FileMode(0o660)))
+		})
+
+		DescribeTable(
+			"it does not expose permissions for other addresses",
+			func(input string) {
+				os.Setenv("FERRITE_LISTEN_ADDR", input)
+
+				v := builder.
+					Required().
+					Value()
+
+				_, ok := v.SocketMode()
+				Expect(ok).To(BeFalse())
+			},
+			Entry("tcp", ":8080"),
+			Entry("abstract unix socket", "unix:@app"),
+		)
+
+		It("applies the permissions when listening", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "app.sock")
+			os.Setenv("FERRITE_LISTEN_ADDR", "unix://"+path)
+
+			v := builder.
+				Required().
+				Value()
+
+			l, err := v.Listen()
+			Expect(err).ShouldNot(HaveOccurred())
+			defer l.Close()
+
+			info, err := os.Stat(path)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(fs.FileMode(0o660)))
+		})
+	})
+})
+
+func ExampleListenAddress_required() {
+	defer example()()
+
+	v := ferrite.
+		ListenAddress("FERRITE_LISTEN_ADDR", "example listen address variable").
+		Required()
+
+	os.Setenv("FERRITE_LISTEN_ADDR", "unix:///run/app.sock")
+	ferrite.Init()
+
+	fmt.Println("network is", v.Value().Network())
+	fmt.Println("address is", v.Value().Address())
+
+	// Output:
+	// network is unix
+	// address is /run/app.sock
+}
+
+func ExampleListenAddress_default() {
+	defer example()()
+
+	v := ferrite.
+		ListenAddress("FERRITE_LISTEN_ADDR", "example listen address variable").
+		WithDefault(":8080").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("network is", v.Value().Network())
+	fmt.Println("address is", v.Value().Address())
+
+	// Output:
+	// network is tcp
+	// address is :8080
+}
+
+func ExampleListenAddress_optional() {
+	defer example()()
+
+	v := ferrite.
+		ListenAddress("FERRITE_LISTEN_ADDR", "example listen address variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
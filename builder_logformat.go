@@ -0,0 +1,131 @@
+package ferrite
+
+import (
+	"io"
+	"log/slog"
+
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// LogFormatValue is a format for structured log output, as produced by a
+// [LogFormat] variable.
+type LogFormatValue string
+
+const (
+	// LogFormatText is the log format produced by [slog.TextHandler].
+	LogFormatText LogFormatValue = "text"
+
+	// LogFormatJSON is the log format produced by [slog.JSONHandler].
+	LogFormatJSON LogFormatValue = "json"
+)
+
+// NewHandler returns a new [slog.Handler] that writes logs to w in format v.
+//
+// It panics if v is not a known format.
+func (v LogFormatValue) NewHandler(w io.Writer, opts *slog.HandlerOptions) slog.Handler {
+	switch v {
+	case LogFormatText:
+		return slog.NewTextHandler(w, opts)
+	case LogFormatJSON:
+		return slog.NewJSONHandler(w, opts)
+	default:
+		panic("unrecognized log format: " + string(v))
+	}
+}
+
+// LogFormat configures an environment variable as the format of structured
+// log output.
+//
+// It is typically used alongside [LogLevel].
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func LogFormat(name, desc string) *LogFormatBuilder {
+	b := &LogFormatBuilder{
+		schema: variable.TypedSet[LogFormatValue]{
+			Members: []variable.SetMember[LogFormatValue]{
+				{
+					Value:       LogFormatText,
+					Description: "human-readable key=value pairs",
+				},
+				{
+					Value:       LogFormatJSON,
+					Description: "machine-readable JSON objects, one per line",
+				},
+			},
+			ToLiteral: func(v LogFormatValue) variable.Literal {
+				return variable.Literal{String: string(v)}
+			},
+			CaseInsensitive: true,
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// LogFormatBuilder builds a specification for a log format variable.
+type LogFormatBuilder struct {
+	schema  variable.TypedSet[LogFormatValue]
+	builder variable.TypedSpecBuilder[LogFormatValue]
+}
+
+var _ isBuilderOfMinimal[
+	LogFormatValue,
+	*LogFormatBuilder,
+]
+
+// WithDefault sets the default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *LogFormatBuilder) WithDefault(v LogFormatValue) *LogFormatBuilder {
+	b.builder.Default(v)
+	return b
+}
+
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *LogFormatBuilder) WithDefaultFunc(
+	fn func() (v LogFormatValue, ok bool),
+	from ...AnyVariableSet,
+) *LogFormatBuilder {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *LogFormatBuilder) WithDefaultIn(p *Profile, v LogFormatValue) *LogFormatBuilder {
+	b.builder.ProfileDefault(p.profile, v)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *LogFormatBuilder) Required(options ...RequiredOption) Required[LogFormatValue] {
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *LogFormatBuilder) Optional(options ...OptionalOption) Optional[LogFormatValue] {
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *LogFormatBuilder) Deprecated(options ...DeprecatedOption) Deprecated[LogFormatValue] {
+	return deprecated(b.schema, &b.builder, options...)
+}
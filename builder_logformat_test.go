@@ -0,0 +1,117 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type LogFormatBuilder", func() {
+	var builder *LogFormatBuilder
+
+	BeforeEach(func() {
+		builder = LogFormat("FERRITE_LOG_FORMAT", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the format",
+					func(value string, expect LogFormatValue) {
+						os.Setenv("FERRITE_LOG_FORMAT", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					},
+					Entry("text", "text", LogFormatText),
+					Entry("json", "json", LogFormatJSON),
+					Entry("uppercase", "JSON", LogFormatJSON),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				It("panics", func() {
+					os.Setenv("FERRITE_LOG_FORMAT", "xml")
+
+					Expect(func() {
+						builder.
+							Required().
+							Value()
+					}).To(PanicWith(
+						`value of FERRITE_LOG_FORMAT (xml) is invalid: expected either text or json`,
+					))
+				})
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(LogFormatJSON).
+							Required().
+							Value()
+
+						Expect(v).To(Equal(LogFormatJSON))
+					})
+				})
+			})
+		})
+	})
+})
+
+var _ = Describe("type LogFormatValue", func() {
+	Describe("func NewHandler()", func() {
+		It("returns a text handler for the text format", func() {
+			var w strings.Builder
+			slog.New(LogFormatText.NewHandler(&w, nil)).Info("<message>")
+			Expect(w.String()).To(ContainSubstring(`msg=<message>`))
+		})
+
+		It("returns a JSON handler for the JSON format", func() {
+			var w strings.Builder
+			slog.New(LogFormatJSON.NewHandler(&w, nil)).Info("<message>")
+			Expect(w.String()).To(ContainSubstring(`"msg":"<message>"`))
+		})
+
+		It("panics if the format is not recognized", func() {
+			Expect(func() {
+				LogFormatValue("xml").NewHandler(&strings.Builder{}, nil)
+			}).To(PanicWith("unrecognized log format: xml"))
+		})
+	})
+})
+
+func ExampleLogFormat() {
+	defer example()()
+
+	format := ferrite.
+		LogFormat("FERRITE_LOG_FORMAT", "example log format variable").
+		WithDefault(ferrite.LogFormatText).
+		Required()
+
+	os.Setenv("FERRITE_LOG_FORMAT", "json")
+	ferrite.Init()
+
+	fmt.Println("value is", format.Value())
+
+	// Output:
+	// value is json
+}
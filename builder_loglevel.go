@@ -0,0 +1,204 @@
+package ferrite
+
+import (
+	"log/slog"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// LogLevel configures an environment variable as a [slog.Level].
+//
+// The value may be one of the standard levels "debug", "info", "warn" or
+// "error", optionally followed by a numeric offset such as "info+2" or
+// "debug-4", using the same syntax as [slog.Level.UnmarshalText]. Levels are
+// not case-sensitive.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func LogLevel(name, desc string) *LogLevelBuilder {
+	b := &LogLevelBuilder{
+		schema: logLevelSchema{
+			variable.TypedSet[slog.Level]{
+				Members: []variable.SetMember[slog.Level]{
+					{
+						Value:       slog.LevelDebug,
+						Description: "diagnostic information that is useful when investigating problems",
+					},
+					{
+						Value:       slog.LevelInfo,
+						Description: "routine information about the application's operation",
+					},
+					{
+						Value:       slog.LevelWarn,
+						Description: "unexpected conditions that do not prevent normal operation",
+					},
+					{
+						Value:       slog.LevelError,
+						Description: "failures that require attention",
+					},
+				},
+				ToLiteral:       formatLogLevel,
+				CaseInsensitive: true,
+			},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.Documentation().
+		Summary("Log level syntax").
+		Paragraph(
+			"In addition to the standard levels, the value may be a standard level followed by a numeric offset,",
+			"such as `info+2` or `debug-4`, to select a level between or beyond the standard levels.",
+			"Each standard level is 4 greater than the one before it,",
+			"such that `info+4` is equivalent to `warn`.",
+		).
+		Format().
+		Done()
+
+	return b
+}
+
+// LogLevelBuilder builds a specification for a log level variable.
+type LogLevelBuilder struct {
+	schema  logLevelSchema
+	builder variable.TypedSpecBuilder[slog.Level]
+}
+
+var _ isBuilderOfMinimal[
+	slog.Level,
+	*LogLevelBuilder,
+]
+
+// WithDefault sets the default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *LogLevelBuilder) WithDefault(v slog.Level) *LogLevelBuilder {
+	b.builder.Default(v)
+	return b
+}
+
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *LogLevelBuilder) WithDefaultFunc(
+	fn func() (v slog.Level, ok bool),
+	from ...AnyVariableSet,
+) *LogLevelBuilder {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *LogLevelBuilder) WithDefaultIn(p *Profile, v slog.Level) *LogLevelBuilder {
+	b.builder.ProfileDefault(p.profile, v)
+	return b
+}
+
+// WithLevelVar configures the variable to update lv with its value.
+//
+// lv is typically passed to a handler via [slog.HandlerOptions] so that the log
+// level can be changed without recreating the handler.
+//
+// lv does not observe the environment directly. It is only updated when the
+// variable is resolved again after the environment has changed, which occurs
+// during [Init] and on each call to the variable's Value() method.
+//
+// lv is not changed if the variable is undefined without a default value, or
+// if its value is invalid. In that case lv retains the last valid level, and
+// the error is reported by [Init] or Value() as usual.
+func (b *LogLevelBuilder) WithLevelVar(lv *slog.LevelVar) *LogLevelBuilder {
+	b.builder.Observer(lv.Set)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *LogLevelBuilder) Required(options ...RequiredOption) Required[slog.Level] {
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *LogLevelBuilder) Optional(options ...OptionalOption) Optional[slog.Level] {
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *LogLevelBuilder) Deprecated(options ...DeprecatedOption) Deprecated[slog.Level] {
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+// logLevelSchema is the schema for a [LogLevel] variable.
+//
+// It is described as a set of the standard levels, but it also accepts levels
+// with numeric offsets, which are not members of the set.
+type logLevelSchema struct {
+	variable.TypedSet[slog.Level]
+}
+
+var _ variable.ExtendedSet = logLevelSchema{}
+
+func (s logLevelSchema) AcceptVisitor(v variable.SchemaVisitor) {
+	v.VisitSet(s)
+}
+
+func (s logLevelSchema) ExtensionDescription() string {
+	return "one of those values followed by a numeric offset, such as `info+2`"
+}
+
+func (s logLevelSchema) Examples(conservative bool) []variable.TypedExample[slog.Level] {
+	examples := s.TypedSet.Examples(conservative)
+
+	if !conservative {
+		examples = append(
+			examples,
+			variable.TypedExample[slog.Level]{
+				Native:      slog.LevelInfo + 2,
+				Description: "a level between info and warn",
+				IsNormative: true,
+			},
+		)
+	}
+
+	return examples
+}
+
+func (s logLevelSchema) Marshal(v slog.Level) (variable.Literal, error) {
+	return formatLogLevel(v), nil
+}
+
+func (s logLevelSchema) Unmarshal(v variable.Literal) (slog.Level, error) {
+	n, err := s.TypedSet.Unmarshal(v)
+	if err == nil {
+		return n, nil
+	}
+
+	var l slog.Level
+	if l.UnmarshalText([]byte(v.String)) == nil {
+		return l, nil
+	}
+
+	// Report the set membership error, which includes a suggestion if the
+	// value is similar to one of the standard levels.
+	return 0, err
+}
+
+// formatLogLevel returns the canonical literal representation of v.
+func formatLogLevel(v slog.Level) variable.Literal {
+	return variable.Literal{
+		String: strings.ToLower(v.String()),
+	}
+}
@@ -0,0 +1,331 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type LogLevelBuilder", func() {
+	var builder *LogLevelBuilder
+
+	BeforeEach(func() {
+		builder = LogLevel("FERRITE_LOG_LEVEL", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			LogLevel("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			LogLevel("FERRITE_LOG_LEVEL", "").Optional()
+		}).To(PanicWith("specification for FERRITE_LOG_LEVEL is invalid: variable description must not be empty"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the level",
+					func(value string, expect slog.Level) {
+						os.Setenv("FERRITE_LOG_LEVEL", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					},
+					Entry("debug", "debug", slog.LevelDebug),
+					Entry("info", "info", slog.LevelInfo),
+					Entry("warn", "warn", slog.LevelWarn),
+					Entry("error", "error", slog.LevelError),
+					Entry("uppercase", "WARN", slog.LevelWarn),
+					Entry("positive offset", "info+2", slog.LevelInfo+2),
+					Entry("negative offset", "debug-4", slog.LevelDebug-4),
+					Entry("offset with mixed case", "Error+1", slog.LevelError+1),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_LOG_LEVEL", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"unknown level",
+						"verbose",
+						`value of FERRITE_LOG_LEVEL (verbose) is invalid: expected debug, info, warn or error`,
+					),
+					Entry(
+						"invalid offset",
+						"info+x",
+						`value of FERRITE_LOG_LEVEL (info+x) is invalid: expected debug, info, warn or error`,
+					),
+					Entry(
+						"near miss",
+						"debg",
+						`value of FERRITE_LOG_LEVEL (debg) is invalid: did you mean debug?`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(slog.LevelWarn).
+							Required().
+							Value()
+
+						Expect(v).To(Equal(slog.LevelWarn))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_LOG_LEVEL is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("there is a level variable", func() {
+		var lv *slog.LevelVar
+
+		BeforeEach(func() {
+			lv = &slog.LevelVar{}
+			lv.Set(slog.LevelError)
+		})
+
+		It("sets the level variable to the default value", func() {
+			builder.
+				WithDefault(slog.LevelWarn).
+				WithLevelVar(lv).
+				Required().
+				Value()
+
+			Expect(lv.Level()).To(Equal(slog.LevelWarn))
+		})
+
+		It("updates the level variable when the environment changes", func() {
+			v := builder.
+				WithLevelVar(lv).
+				Required()
+
+			os.Setenv("FERRITE_LOG_LEVEL", "debug")
+			v.Value()
+			Expect(lv.Level()).To(Equal(slog.LevelDebug))
+
+			os.Setenv("FERRITE_LOG_LEVEL", "info+2")
+			v.Value()
+			Expect(lv.Level()).To(Equal(slog.LevelInfo + 2))
+		})
+
+		It("does not change the level variable if the value is invalid", func() {
+			v := builder.
+				WithLevelVar(lv).
+				Optional()
+
+			os.Setenv("FERRITE_LOG_LEVEL", "verbose")
+			Expect(func() {
+				v.Value()
+			}).To(Panic())
+
+			Expect(lv.Level()).To(Equal(slog.LevelError))
+		})
+
+		It("reports an error without changing the level variable if a valid value is replaced by an invalid one", func() {
+			v := builder.
+				WithLevelVar(lv).
+				Required()
+
+			os.Setenv("FERRITE_LOG_LEVEL", "debug")
+			v.Value()
+			Expect(lv.Level()).To(Equal(slog.LevelDebug))
+
+			os.Setenv("FERRITE_LOG_LEVEL", "verbose")
+			Expect(func() {
+				v.Value()
+			}).To(PanicWith("value of FERRITE_LOG_LEVEL (verbose) is invalid: expected debug, info, warn or error"))
+
+			Expect(lv.Level()).To(Equal(slog.LevelDebug))
+		})
+
+		It("does not change the level variable if the value is undefined", func() {
+			builder.
+				WithLevelVar(lv).
+				Optional().
+				Value()
+
+			Expect(lv.Level()).To(Equal(slog.LevelError))
+		})
+	})
+})
+
+func ExampleLogLevel_required() {
+	defer example()()
+
+	v := ferrite.
+		LogLevel("FERRITE_LOG_LEVEL", "example log level variable").
+		Required()
+
+	os.Setenv("FERRITE_LOG_LEVEL", "warn")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is WARN
+}
+
+func ExampleLogLevel_default() {
+	defer example()()
+
+	v := ferrite.
+		LogLevel("FERRITE_LOG_LEVEL", "example log level variable").
+		WithDefault(slog.LevelInfo).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is INFO
+}
+
+func ExampleLogLevel_optional() {
+	defer example()()
+
+	v := ferrite.
+		LogLevel("FERRITE_LOG_LEVEL", "example log level variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleLogLevel_deprecated() {
+	defer example()()
+
+	v := ferrite.
+		LogLevel("FERRITE_LOG_LEVEL", "example log level variable").
+		Deprecated()
+
+	os.Setenv("FERRITE_LOG_LEVEL", "DEBUG")
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_LOG_LEVEL  example log level variable  [ debug | info | warn | error ]  ⚠ deprecated variable set to DEBUG, equivalent to debug
+	//
+	// value is DEBUG
+}
+
+func ExampleLogLevel_offset() {
+	defer example()()
+
+	v := ferrite.
+		LogLevel("FERRITE_LOG_LEVEL", "example log level variable").
+		Required()
+
+	os.Setenv("FERRITE_LOG_LEVEL", "info+2")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is INFO+2
+}
+
+func ExampleLogLevel_levelVar() {
+	defer example()()
+
+	var level slog.LevelVar
+
+	v := ferrite.
+		LogLevel("FERRITE_LOG_LEVEL", "example log level variable").
+		WithDefault(slog.LevelInfo).
+		WithLevelVar(&level).
+		Required()
+
+	os.Setenv("FERRITE_LOG_LEVEL", "debug")
+	ferrite.Init()
+
+	fmt.Println("level is", level.Level())
+
+	// The level variable is updated whenever the value is resolved from a
+	// changed environment.
+	os.Setenv("FERRITE_LOG_LEVEL", "error")
+	v.Value()
+
+	fmt.Println("level is", level.Level())
+
+	// Output:
+	// level is DEBUG
+	// level is ERROR
+}
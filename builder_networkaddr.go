@@ -1,7 +1,6 @@
 package ferrite
 
 import (
-	"errors"
 	"net"
 
 	"github.com/dogmatiq/ferrite/internal/variable"
@@ -35,10 +34,7 @@ func NetworkAddress(name, desc string) *NetworkAddressBuilder {
 	b.builder.BuiltInConstraint(
 		"**MUST** be a valid network address",
 		func(_ variable.ConstraintContext, v NetworkAddr) variable.ConstraintError {
-			if v.Host == "" {
-				return errors.New("host must not be empty")
-			}
-			return validatePort(v.Port)
+			return validateNetworkAddr(v)
 		},
 	)
 	b.builder.NonNormativeExample(
@@ -53,7 +49,7 @@ func NetworkAddress(name, desc string) *NetworkAddressBuilder {
 		mustParseNetworkAddr("host.example.org:https"),
 		"a named host with an IANA service name",
 	)
-	buildNetworkAddrSyntaxDocumentation(b.builder.Documentation())
+	buildNetworkAddrSyntaxDocumentation(b.builder.Documentation()).Done()
 
 	return b
 }
@@ -78,6 +74,33 @@ func (b *NetworkAddressBuilder) WithDefault(v string) *NetworkAddressBuilder {
 	return b
 }
 
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *NetworkAddressBuilder) WithDefaultFunc(
+	fn func() (v NetworkAddr, ok bool),
+	from ...AnyVariableSet,
+) *NetworkAddressBuilder {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *NetworkAddressBuilder) WithDefaultIn(p *Profile, v string) *NetworkAddressBuilder {
+	b.builder.ProfileDefault(p.profile, mustParseNetworkAddr(v))
+	return b
+}
+
 // WithExample adds an example value to the variable's documentation.
 func (b *NetworkAddressBuilder) WithExample(v string, desc string) *NetworkAddressBuilder {
 	b.builder.NormativeExample(mustParseNetworkAddr(v), desc)
@@ -126,8 +149,12 @@ func mustParseNetworkAddr(v string) NetworkAddr {
 	return NetworkAddr{Host: host, Port: port}
 }
 
-func buildNetworkAddrSyntaxDocumentation(d variable.DocumentationBuilder) {
-	d.
+// buildNetworkAddrSyntaxDocumentation adds the documentation that describes
+// the syntax of a network address to d.
+//
+// It returns d so that the caller may add more detail before calling Done().
+func buildNetworkAddrSyntaxDocumentation(d variable.DocumentationBuilder) variable.DocumentationBuilder {
+	return d.
 		Summary("Network address syntax").
 		Paragraph(
 			"Addresses may be specified as `<host>:<port>`, where `<host>` is a",
@@ -135,6 +162,5 @@ func buildNetworkAddrSyntaxDocumentation(d variable.DocumentationBuilder) {
 			"IANA service name.",
 			"IPv6 addresses must be enclosed in square brackets, e.g. `[::1]:8080`.",
 		).
-		Format().
-		Done()
+		Format()
 }
@@ -0,0 +1,407 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/inflect"
+	"github.com/dogmatiq/ferrite/internal/maybe"
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// NetworkAddressList configures an environment variable as a comma-separated
+// list of network addresses in host:port form, such as a list of message
+// brokers or cluster peers.
+//
+// Each address uses the same syntax as [NetworkAddress].
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func NetworkAddressList(name, desc string) *NetworkAddressListBuilder {
+	b := &NetworkAddressListBuilder{}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// NetworkAddressListBuilder builds a specification for a list of network
+// addresses.
+type NetworkAddressListBuilder struct {
+	schema      networkAddrListSchema
+	builder     variable.TypedSpecBuilder[[]NetworkAddr]
+	def         maybe.Value[string]
+	profileDefs []profileDefault[string]
+	examples    []variable.TypedExample[string]
+	min         maybe.Value[int]
+	max         maybe.Value[int]
+}
+
+var _ isBuilderOf[
+	[]NetworkAddr,
+	string,
+	*NetworkAddressListBuilder,
+]
+
+var _ isFinalizedBuilder[*NetworkAddressListBuilder]
+
+// WithDefault sets the default value of the variable.
+//
+// v is a comma-separated list of addresses, using the same syntax as the
+// environment variable itself. It is used when the environment variable is
+// undefined or empty.
+func (b *NetworkAddressListBuilder) WithDefault(v string) *NetworkAddressListBuilder {
+	b.def = maybe.Some(v)
+	return b
+}
+
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *NetworkAddressListBuilder) WithDefaultFunc(
+	fn func() (v []NetworkAddr, ok bool),
+	from ...AnyVariableSet,
+) *NetworkAddressListBuilder {
+	b.def = maybe.None[string]()
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *NetworkAddressListBuilder) WithDefaultIn(p *Profile, v string) *NetworkAddressListBuilder {
+	b.profileDefs = append(b.profileDefs, profileDefault[string]{p, v})
+	return b
+}
+
+// WithExample adds an example value to the variable's documentation.
+func (b *NetworkAddressListBuilder) WithExample(v string, desc string) *NetworkAddressListBuilder {
+	b.examples = append(b.examples, variable.TypedExample[string]{
+		Native:      v,
+		Description: desc,
+		IsNormative: true,
+	})
+	return b
+}
+
+// WithDefaultPort sets the port that is used for any address that does not
+// specify a port, such as "9092" or "https".
+func (b *NetworkAddressListBuilder) WithDefaultPort(port string) *NetworkAddressListBuilder {
+	b.schema.DefaultPort = port
+	return b
+}
+
+// WithDeduplication removes duplicate addresses from the list, retaining the
+// first occurrence of each address.
+//
+// Hostnames are compared without regard to case.
+func (b *NetworkAddressListBuilder) WithDeduplication() *NetworkAddressListBuilder {
+	b.schema.Deduplicate = true
+	return b
+}
+
+// WithMinimumAddresses sets the minimum number of addresses in the list.
+//
+// If deduplication is enabled, duplicates are not counted.
+func (b *NetworkAddressListBuilder) WithMinimumAddresses(n int) *NetworkAddressListBuilder {
+	b.min = maybe.Some(n)
+	return b
+}
+
+// WithMaximumAddresses sets the maximum number of addresses in the list.
+//
+// If deduplication is enabled, duplicates are not counted.
+func (b *NetworkAddressListBuilder) WithMaximumAddresses(n int) *NetworkAddressListBuilder {
+	b.max = maybe.Some(n)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *NetworkAddressListBuilder) Required(options ...RequiredOption) Required[[]NetworkAddr] {
+	b.finalize()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *NetworkAddressListBuilder) Optional(options ...OptionalOption) Optional[[]NetworkAddr] {
+	b.finalize()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *NetworkAddressListBuilder) Deprecated(options ...DeprecatedOption) Deprecated[[]NetworkAddr] {
+	b.finalize()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+// finalize adds the constraint on the number of addresses in the list.
+func (b *NetworkAddressListBuilder) finalize() {
+	min, hasMin := b.min.Get()
+	max, hasMax := b.max.Get()
+
+	var w strings.Builder
+	w.WriteString("**MUST** be a comma-separated list of ")
+
+	if hasMin && hasMax {
+		if min == max {
+			fmt.Fprintf(&w, "exactly %d ", min)
+		} else {
+			fmt.Fprintf(&w, "between %d and %d ", min, max)
+		}
+	} else if hasMin {
+		fmt.Fprintf(&w, "at least %d ", min)
+	} else if hasMax {
+		fmt.Fprintf(&w, "at most %d ", max)
+	}
+
+	w.WriteString("network addresses")
+
+	b.builder.BuiltInConstraint(
+		w.String(),
+		func(_ variable.ConstraintContext, v []NetworkAddr) variable.ConstraintError {
+			for i, addr := range v {
+				if err := validateNetworkAddr(addr); err != nil {
+					return fmt.Errorf(
+						"address %d (%s) is invalid: %w",
+						i+1,
+						variable.Literal{String: addr.String()}.Quote(),
+						err,
+					)
+				}
+			}
+
+			n := len(v)
+
+			if hasMin && n < min {
+				return fmt.Errorf(
+					"expected at least %d %s, got %d",
+					min,
+					inflect.Pluralize("address", min),
+					n,
+				)
+			}
+
+			if hasMax && n > max {
+				return fmt.Errorf(
+					"expected at most %d %s, got %d",
+					max,
+					inflect.Pluralize("address", max),
+					n,
+				)
+			}
+
+			return nil
+		},
+	)
+
+	doc := buildNetworkAddrSyntaxDocumentation(b.builder.Documentation()).
+		Paragraph(
+			"Multiple addresses are separated by commas, such as `%s`.",
+			"Whitespace around each address is ignored.",
+		).
+		Format("10.0.0.1:9092,10.0.0.2:9092")
+
+	if port := b.schema.DefaultPort; port != "" {
+		doc = doc.
+			Paragraph(
+				"The port may be omitted, in which case port `%s` is used.",
+				"IPv6 addresses without a port must still be enclosed in square brackets, e.g. `[::1]`.",
+			).
+			Format(port)
+	}
+
+	if b.schema.Deduplicate {
+		doc = doc.
+			Paragraph("Duplicate addresses are ignored.").
+			Format()
+	}
+
+	doc.Done()
+
+	if v, ok := b.def.Get(); ok {
+		b.builder.Default(mustParseNetworkAddrList(b.schema, v))
+	}
+
+	for _, d := range b.profileDefs {
+		b.builder.ProfileDefault(d.profile.profile, mustParseNetworkAddrList(b.schema, d.value))
+	}
+
+	for _, eg := range b.examples {
+		b.builder.NormativeExample(
+			mustParseNetworkAddrList(b.schema, eg.Native),
+			eg.Description,
+		)
+	}
+}
+
+// networkAddrListSchema is the schema for a [NetworkAddressList] variable.
+type networkAddrListSchema struct {
+	DefaultPort string
+	Deduplicate bool
+}
+
+func (s networkAddrListSchema) Type() reflect.Type {
+	return reflectx.TypeOf[[]NetworkAddr]()
+}
+
+func (s networkAddrListSchema) Finalize() error {
+	if s.DefaultPort != "" {
+		if err := validatePort(s.DefaultPort); err != nil {
+			return fmt.Errorf("default port: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s networkAddrListSchema) AcceptVisitor(v variable.SchemaVisitor) {
+	v.VisitOther(s)
+}
+
+func (s networkAddrListSchema) Marshal(v []NetworkAddr) (variable.Literal, error) {
+	if len(v) == 0 {
+		return variable.Literal{}, errors.New("expected at least one address")
+	}
+
+	parts := make([]string, len(v))
+	for i, addr := range v {
+		lit, err := networkAddrMarshaler{}.Marshal(addr)
+		if err != nil {
+			return variable.Literal{}, err
+		}
+		parts[i] = lit.String
+	}
+
+	return variable.Literal{
+		String: strings.Join(parts, ","),
+	}, nil
+}
+
+func (s networkAddrListSchema) Unmarshal(v variable.Literal) ([]NetworkAddr, error) {
+	var list []NetworkAddr
+
+	for i, p := range strings.Split(v.String, ",") {
+		p = strings.TrimSpace(p)
+
+		if p == "" {
+			return nil, fmt.Errorf("address %d is empty", i+1)
+		}
+
+		addr, err := s.unmarshalAddr(p)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"address %d (%s) is invalid: %w",
+				i+1,
+				variable.Literal{String: p}.Quote(),
+				err,
+			)
+		}
+
+		if s.Deduplicate && containsNetworkAddr(list, addr) {
+			continue
+		}
+
+		list = append(list, addr)
+	}
+
+	return list, nil
+}
+
+// unmarshalAddr parses a single address within the list, applying the default
+// port if necessary.
+func (s networkAddrListSchema) unmarshalAddr(p string) (NetworkAddr, error) {
+	addr, err := networkAddrMarshaler{}.Unmarshal(variable.Literal{String: p})
+	if err == nil || s.DefaultPort == "" {
+		return addr, err
+	}
+
+	// Only apply the default port if the port is missing, not if the address
+	// is malformed in some other way, such as an IPv6 address without square
+	// brackets.
+	var addrErr *net.AddrError
+	if !errors.As(err, &addrErr) || addrErr.Err != "missing port in address" {
+		return NetworkAddr{}, err
+	}
+
+	host := p
+	if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+		host = host[1 : len(host)-1]
+	}
+
+	return NetworkAddr{Host: host, Port: s.DefaultPort}, nil
+}
+
+func (s networkAddrListSchema) Examples(conservative bool) []variable.TypedExample[[]NetworkAddr] {
+	port := s.DefaultPort
+	if port == "" {
+		port = "9092"
+	}
+
+	examples := []variable.TypedExample[[]NetworkAddr]{
+		{
+			Native: []NetworkAddr{
+				{Host: "10.0.0.1", Port: port},
+				{Host: "10.0.0.2", Port: port},
+				{Host: "10.0.0.3", Port: port},
+			},
+			Description: "a list of IPv4 addresses",
+		},
+	}
+
+	if !conservative {
+		examples = append(
+			examples,
+			variable.TypedExample[[]NetworkAddr]{
+				Native: []NetworkAddr{
+					{Host: "host-1.example.org", Port: port},
+					{Host: "::1", Port: port},
+				},
+				Description: "a mix of named hosts and IPv6 addresses",
+			},
+		)
+	}
+
+	return examples
+}
+
+// validateNetworkAddr returns an error if addr is not a valid network address.
+func validateNetworkAddr(addr NetworkAddr) error {
+	if addr.Host == "" {
+		return errors.New("host must not be empty")
+	}
+	return validatePort(addr.Port)
+}
+
+// containsNetworkAddr returns true if list contains addr.
+func containsNetworkAddr(list []NetworkAddr, addr NetworkAddr) bool {
+	for _, x := range list {
+		if strings.EqualFold(x.Host, addr.Host) && x.Port == addr.Port {
+			return true
+		}
+	}
+	return false
+}
+
+func mustParseNetworkAddrList(s networkAddrListSchema, v string) []NetworkAddr {
+	list, err := s.Unmarshal(variable.Literal{String: v})
+	if err != nil {
+		panic(err)
+	}
+	return list
+}
@@ -0,0 +1,327 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func NetworkAddressList", func() {
+	var builder *NetworkAddressListBuilder
+
+	BeforeEach(func() {
+		builder = NetworkAddressList("FERRITE_NETWORK_ADDR_LIST", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			NetworkAddressList("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			NetworkAddressList("FERRITE_NETWORK_ADDR_LIST", "").Optional()
+		}).To(PanicWith("specification for FERRITE_NETWORK_ADDR_LIST is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the default port is invalid", func() {
+		Expect(func() {
+			builder.
+				WithDefaultPort("0").
+				Optional()
+		}).To(PanicWith("specification for FERRITE_NETWORK_ADDR_LIST is invalid: default port: numeric ports must be between 1 and 65535"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the parsed addresses",
+					func(input string, expected []NetworkAddr) {
+						os.Setenv("FERRITE_NETWORK_ADDR_LIST", input)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expected))
+					},
+					Entry(
+						"single address",
+						"192.168.0.1:8080",
+						[]NetworkAddr{
+							{Host: "192.168.0.1", Port: "8080"},
+						},
+					),
+					Entry(
+						"multiple addresses",
+						"192.168.0.1:8080,[::1]:8080,host.example.org:https",
+						[]NetworkAddr{
+							{Host: "192.168.0.1", Port: "8080"},
+							{Host: "::1", Port: "8080"},
+							{Host: "host.example.org", Port: "https"},
+						},
+					),
+					Entry(
+						"whitespace around addresses",
+						" a:1 , b:2 ",
+						[]NetworkAddr{
+							{Host: "a", Port: "1"},
+							{Host: "b", Port: "2"},
+						},
+					),
+					Entry(
+						"duplicate addresses",
+						"a:1,a:1",
+						[]NetworkAddr{
+							{Host: "a", Port: "1"},
+							{Host: "a", Port: "1"},
+						},
+					),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(input, expect string) {
+						os.Setenv("FERRITE_NETWORK_ADDR_LIST", input)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"empty element",
+						"a:1,,b:2",
+						`value of FERRITE_NETWORK_ADDR_LIST (a:1,,b:2) is invalid: address 2 is empty`,
+					),
+					Entry(
+						"missing port",
+						"a:1,b",
+						`value of FERRITE_NETWORK_ADDR_LIST (a:1,b) is invalid: address 2 (b) is invalid: address b: missing port in address`,
+					),
+					Entry(
+						"empty host",
+						"a:1,:2",
+						`value of FERRITE_NETWORK_ADDR_LIST (a:1,:2) is invalid: address 2 (:2) is invalid: host must not be empty`,
+					),
+					Entry(
+						"invalid port",
+						"a:1,b:65536",
+						`value of FERRITE_NETWORK_ADDR_LIST (a:1,b:65536) is invalid: address 2 (b:65536) is invalid: numeric ports must be between 1 and 65535`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("a:1,b:2").
+							Required().
+							Value()
+
+						Expect(v).To(Equal([]NetworkAddr{
+							{Host: "a", Port: "1"},
+							{Host: "b", Port: "2"},
+						}))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_NETWORK_ADDR_LIST is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("there is a default port", func() {
+		BeforeEach(func() {
+			builder.WithDefaultPort("9092")
+		})
+
+		DescribeTable(
+			"it uses the default port when the port is omitted",
+			func(input string, expected []NetworkAddr) {
+				os.Setenv("FERRITE_NETWORK_ADDR_LIST", input)
+
+				v := builder.
+					Required().
+					Value()
+
+				Expect(v).To(Equal(expected))
+			},
+			Entry(
+				"hostname",
+				"a,b:1234",
+				[]NetworkAddr{
+					{Host: "a", Port: "9092"},
+					{Host: "b", Port: "1234"},
+				},
+			),
+			Entry(
+				"bracketed IPv6 address",
+				"[::1]",
+				[]NetworkAddr{
+					{Host: "::1", Port: "9092"},
+				},
+			),
+		)
+
+		It("does not treat an IPv6 address without brackets as a host", func() {
+			os.Setenv("FERRITE_NETWORK_ADDR_LIST", "::1")
+
+			Expect(func() {
+				builder.
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_NETWORK_ADDR_LIST (::1) is invalid: address 1 (::1) is invalid: address ::1: too many colons in address`,
+			))
+		})
+
+		It("applies the default port to the default value", func() {
+			v := NetworkAddressList("FERRITE_NETWORK_ADDR_LIST", "<desc>").
+				WithDefault("a,b").
+				WithDefaultPort("9092").
+				Required().
+				Value()
+
+			Expect(v).To(Equal([]NetworkAddr{
+				{Host: "a", Port: "9092"},
+				{Host: "b", Port: "9092"},
+			}))
+		})
+	})
+
+	When("deduplication is enabled", func() {
+		It("removes duplicate addresses", func() {
+			os.Setenv("FERRITE_NETWORK_ADDR_LIST", "a:1,b:2,A:1,b:3")
+
+			v := builder.
+				WithDeduplication().
+				Required().
+				Value()
+
+			Expect(v).To(Equal([]NetworkAddr{
+				{Host: "a", Port: "1"},
+				{Host: "b", Port: "2"},
+				{Host: "b", Port: "3"},
+			}))
+		})
+
+		It("does not count duplicates toward the minimum", func() {
+			os.Setenv("FERRITE_NETWORK_ADDR_LIST", "a:1,a:1")
+
+			Expect(func() {
+				builder.
+					WithDeduplication().
+					WithMinimumAddresses(2).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_NETWORK_ADDR_LIST (a:1,a:1) is invalid: expected at least 2 addresses, got 1`,
+			))
+		})
+	})
+
+	When("there is a maximum number of addresses", func() {
+		It("panics if there are too many addresses", func() {
+			os.Setenv("FERRITE_NETWORK_ADDR_LIST", "a:1,b:2")
+
+			Expect(func() {
+				builder.
+					WithMaximumAddresses(1).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_NETWORK_ADDR_LIST (a:1,b:2) is invalid: expected at most 1 address, got 2`,
+			))
+		})
+	})
+})
+
+func ExampleNetworkAddressList_required() {
+	defer example()()
+
+	v := ferrite.
+		NetworkAddressList("FERRITE_NETWORK_ADDR_LIST", "example network address list variable").
+		Required()
+
+	os.Setenv("FERRITE_NETWORK_ADDR_LIST", "10.0.0.1:9092,10.0.0.2:9092")
+	ferrite.Init()
+
+	for _, addr := range v.Value() {
+		fmt.Println("address is", addr)
+	}
+
+	// Output:
+	// address is 10.0.0.1:9092
+	// address is 10.0.0.2:9092
+}
+
+func ExampleNetworkAddressList_defaultPort() {
+	defer example()()
+
+	v := ferrite.
+		NetworkAddressList("FERRITE_NETWORK_ADDR_LIST", "example network address list variable").
+		WithDefaultPort("11211").
+		WithDeduplication().
+		Required()
+
+	os.Setenv("FERRITE_NETWORK_ADDR_LIST", "cache-1, cache-2:11212, cache-1")
+	ferrite.Init()
+
+	for _, addr := range v.Value() {
+		fmt.Println("address is", addr)
+	}
+
+	// Output:
+	// address is cache-1:11211
+	// address is cache-2:11212
+}
+
+func ExampleNetworkAddressList_optional() {
+	defer example()()
+
+	v := ferrite.
+		NetworkAddressList("FERRITE_NETWORK_ADDR_LIST", "example network address list variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
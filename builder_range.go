@@ -0,0 +1,372 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dogmatiq/ferrite/internal/maybe"
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"github.com/dogmatiq/ferrite/internal/variable"
+	"golang.org/x/exp/constraints"
+)
+
+// Range is an inclusive range of values between two bounds, as produced by a
+// [RangeOf] variable.
+type Range[T constraints.Integer | constraints.Float] struct {
+	// Low is the lower bound of the range.
+	Low T
+
+	// High is the upper bound of the range. It is never less than Low.
+	High T
+}
+
+// Contains returns true if v is within the range, inclusive of its bounds.
+func (r Range[T]) Contains(v T) bool {
+	return v >= r.Low && v <= r.High
+}
+
+// RangeElementBuilder is a builder that describes the bounds of a [Range].
+//
+// It is implemented by the builders returned by [Signed], [Unsigned], [Float],
+// [Duration] and [NetworkPort].
+type RangeElementBuilder[T constraints.Integer | constraints.Float] interface {
+	// rangeElementSchema returns the schema that is applied to each bound.
+	rangeElementSchema() variable.TypedNumeric[T]
+}
+
+const (
+	// rangeSeparator is the canonical separator between the bounds of a range.
+	rangeSeparator = ".."
+
+	// rangeAlternateSeparator is an alternative separator between the bounds of
+	// a range.
+	rangeAlternateSeparator = "-"
+)
+
+// RangeOf configures an environment variable as a range of values, such as
+// "100ms..5s" or "30000-32767".
+//
+// The bounds are parsed and validated according to element, which is typically
+// the builder for another variable type, such as [Unsigned] or [Duration]. The
+// element's syntax and minimum and maximum limits apply to both bounds. Only
+// the element's schema is used; its name, description, default value and any
+// other constraints are ignored, so it is conventional to pass empty strings
+// for the element's name and description.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func RangeOf[T constraints.Integer | constraints.Float](
+	name, desc string,
+	element RangeElementBuilder[T],
+) *RangeBuilder[T] {
+	b := &RangeBuilder[T]{
+		schema: rangeSchema[T]{
+			Element: element.rangeElementSchema(),
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.BuiltInConstraint(
+		b.schema.describe(),
+		func(_ variable.ConstraintContext, v Range[T]) variable.ConstraintError {
+			if v.Low > v.High {
+				return errors.New("the lower bound must not be greater than the upper bound")
+			}
+			return nil
+		},
+	)
+	b.builder.Documentation().
+		Summary("Range syntax").
+		Paragraph(
+			"Ranges are specified as a lower bound and an upper bound, separated by `%s`, such as `%s`.",
+			"The bounds may also be separated by a single hyphen (`%s`).",
+			"Both bounds are inclusive, and they may be equal.",
+		).
+		Format(
+			rangeSeparator,
+			"low"+rangeSeparator+"high",
+			rangeAlternateSeparator,
+		).
+		Done()
+
+	return b
+}
+
+// RangeBuilder builds a specification for a range of values.
+type RangeBuilder[T constraints.Integer | constraints.Float] struct {
+	schema  rangeSchema[T]
+	builder variable.TypedSpecBuilder[Range[T]]
+}
+
+var _ isBuilderOfMinimal[
+	Range[int],
+	*RangeBuilder[int],
+]
+
+// WithDefault sets the default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *RangeBuilder[T]) WithDefault(low, high T) *RangeBuilder[T] {
+	b.builder.Default(Range[T]{low, high})
+	return b
+}
+
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *RangeBuilder[T]) WithDefaultFunc(
+	fn func() (v Range[T], ok bool),
+	from ...AnyVariableSet,
+) *RangeBuilder[T] {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *RangeBuilder[T]) WithDefaultIn(p *Profile, low, high T) *RangeBuilder[T] {
+	b.builder.ProfileDefault(p.profile, Range[T]{low, high})
+	return b
+}
+
+// WithExample adds an example value to the variable's documentation.
+func (b *RangeBuilder[T]) WithExample(low, high T, desc string) *RangeBuilder[T] {
+	b.builder.NormativeExample(Range[T]{low, high}, desc)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *RangeBuilder[T]) Required(options ...RequiredOption) Required[Range[T]] {
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *RangeBuilder[T]) Optional(options ...OptionalOption) Optional[Range[T]] {
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *RangeBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[Range[T]] {
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *SignedBuilder[T]) rangeElementSchema() variable.TypedNumeric[T] {
+	s := b.schema
+	s.Marshaler = b.marshaler
+	return s
+}
+
+func (b *UnsignedBuilder[T]) rangeElementSchema() variable.TypedNumeric[T] {
+	s := b.schema
+	s.Marshaler = b.marshaler
+	return s
+}
+
+func (b *FloatBuilder[T]) rangeElementSchema() variable.TypedNumeric[T] {
+	s := b.schema
+	s.Marshaler = b.marshaler
+	return s
+}
+
+func (b *DurationBuilder) rangeElementSchema() variable.TypedNumeric[time.Duration] {
+	s := b.schema
+	s.Marshaler = b.marshaler
+	return s
+}
+
+// rangeElementSchema returns the schema for a range of ports. Unlike a single
+// port, the bounds of a port range must be numeric; IANA service names are not
+// supported.
+func (b *NetworkPortBuilder) rangeElementSchema() variable.TypedNumeric[uint16] {
+	return variable.TypedNumeric[uint16]{
+		Marshaler: unsignedMarshaler[uint16]{},
+		NativeMin: maybe.Some[uint16](1),
+		NativeMax: maybe.Some[uint16](65535),
+	}
+}
+
+// rangeSchema is the schema for a [RangeOf] variable.
+type rangeSchema[T constraints.Integer | constraints.Float] struct {
+	Element variable.TypedNumeric[T]
+}
+
+// describe returns a description of the requirements of the range, suitable
+// for use as the primary requirement in documentation.
+func (s rangeSchema[T]) describe() string {
+	var w strings.Builder
+
+	fmt.Fprintf(
+		&w,
+		"**MUST** be a range in the form `low%shigh`",
+		rangeSeparator,
+	)
+
+	min, hasMin := s.Element.Min()
+	max, hasMax := s.Element.Max()
+
+	if hasMin && hasMax {
+		fmt.Fprintf(&w, ", where both bounds are between `%s` and `%s`", min.String, max.String)
+	} else if hasMin {
+		fmt.Fprintf(&w, ", where both bounds are `%s` or greater", min.String)
+	} else if hasMax {
+		fmt.Fprintf(&w, ", where both bounds are `%s` or less", max.String)
+	}
+
+	return w.String()
+}
+
+func (s rangeSchema[T]) Type() reflect.Type {
+	return reflectx.TypeOf[Range[T]]()
+}
+
+func (s rangeSchema[T]) Finalize() error {
+	return s.Element.Finalize()
+}
+
+func (s rangeSchema[T]) AcceptVisitor(v variable.SchemaVisitor) {
+	v.VisitOther(s)
+}
+
+func (s rangeSchema[T]) Marshal(v Range[T]) (variable.Literal, error) {
+	low, err := s.Element.Marshal(v.Low)
+	if err != nil {
+		return variable.Literal{}, fmt.Errorf("lower bound: %w", err)
+	}
+
+	high, err := s.Element.Marshal(v.High)
+	if err != nil {
+		return variable.Literal{}, fmt.Errorf("upper bound: %w", err)
+	}
+
+	return variable.Literal{
+		String: low.String + rangeSeparator + high.String,
+	}, nil
+}
+
+func (s rangeSchema[T]) Unmarshal(v variable.Literal) (Range[T], error) {
+	if low, high, ok := strings.Cut(v.String, rangeSeparator); ok {
+		return s.unmarshalBounds(low, high)
+	}
+
+	// The alternate separator may also appear within a bound, such as in a
+	// negative number, so each hyphen is tried in turn until one yields two
+	// valid bounds. The search starts at the second character so that a
+	// leading sign is never treated as a separator.
+	var firstErr error
+
+	for i := 1; i < len(v.String); i++ {
+		if !strings.HasPrefix(v.String[i:], rangeAlternateSeparator) {
+			continue
+		}
+
+		r, err := s.unmarshalBounds(
+			v.String[:i],
+			v.String[i+len(rangeAlternateSeparator):],
+		)
+		if err == nil {
+			return r, nil
+		}
+
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	if firstErr != nil {
+		return Range[T]{}, firstErr
+	}
+
+	return Range[T]{}, fmt.Errorf(
+		"expected a range in the form low%shigh",
+		rangeSeparator,
+	)
+}
+
+// unmarshalBounds parses the lower and upper bounds of a range.
+func (s rangeSchema[T]) unmarshalBounds(low, high string) (Range[T], error) {
+	var (
+		r   Range[T]
+		err error
+	)
+
+	r.Low, err = s.unmarshalBound("lower", low)
+	if err != nil {
+		return Range[T]{}, err
+	}
+
+	r.High, err = s.unmarshalBound("upper", high)
+	if err != nil {
+		return Range[T]{}, err
+	}
+
+	return r, nil
+}
+
+// unmarshalBound parses a single bound of a range.
+func (s rangeSchema[T]) unmarshalBound(which, v string) (T, error) {
+	v = strings.TrimSpace(v)
+
+	if v == "" {
+		return 0, fmt.Errorf("the %s bound is empty", which)
+	}
+
+	n, err := s.Element.Unmarshal(variable.Literal{String: v})
+	if err != nil {
+		return 0, fmt.Errorf(
+			"the %s bound (%s) is invalid: %w",
+			which,
+			variable.Literal{String: v}.Quote(),
+			err,
+		)
+	}
+
+	return n, nil
+}
+
+func (s rangeSchema[T]) Examples(conservative bool) []variable.TypedExample[Range[T]] {
+	var bounds []T
+	for _, x := range s.Element.Examples(conservative) {
+		bounds = append(bounds, x.Native)
+	}
+
+	slices.Sort(bounds)
+	bounds = slices.Compact(bounds)
+
+	if len(bounds) < 2 {
+		return nil
+	}
+
+	examples := []variable.TypedExample[Range[T]]{
+		{
+			Native: Range[T]{bounds[0], bounds[len(bounds)-1]},
+		},
+	}
+
+	if !conservative && len(bounds) > 2 {
+		examples = append(
+			examples,
+			variable.TypedExample[Range[T]]{
+				Native: Range[T]{bounds[1], bounds[2]},
+			},
+		)
+	}
+
+	return examples
+}
@@ -0,0 +1,288 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type RangeBuilder", func() {
+	var builder *RangeBuilder[int16]
+
+	BeforeEach(func() {
+		builder = RangeOf(
+			"FERRITE_RANGE",
+			"<desc>",
+			Signed[int16]("", "").
+				WithMinimum(-100).
+				WithMaximum(100),
+		)
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			RangeOf("", "<desc>", Signed[int16]("", "")).Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			RangeOf("FERRITE_RANGE", "", Signed[int16]("", "")).Optional()
+		}).To(PanicWith("specification for FERRITE_RANGE is invalid: variable description must not be empty"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the range",
+					func(value string, low, high int16) {
+						os.Setenv("FERRITE_RANGE", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(Range[int16]{low, high}))
+					},
+					Entry("dot-dot separator", "10..20", int16(10), int16(20)),
+					Entry("hyphen separator", "10-20", int16(10), int16(20)),
+					Entry("equal bounds", "10..10", int16(10), int16(10)),
+					Entry("negative bounds", "-20..-10", int16(-20), int16(-10)),
+					Entry("negative bounds with hyphen separator", "-20--10", int16(-20), int16(-10)),
+					Entry("whitespace around bounds", " 10 .. 20 ", int16(10), int16(20)),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_RANGE", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"no separator",
+						"10",
+						`value of FERRITE_RANGE (10) is invalid: expected a range in the form low..high`,
+					),
+					Entry(
+						"empty bound",
+						"10..",
+						`value of FERRITE_RANGE (10..) is invalid: the upper bound is empty`,
+					),
+					Entry(
+						"invalid bound",
+						"10..x",
+						`value of FERRITE_RANGE (10..x) is invalid: the upper bound (x) is invalid: unrecognized int16 syntax`,
+					),
+					Entry(
+						"bound exceeds element limits",
+						"-200..20",
+						`value of FERRITE_RANGE (-200..20) is invalid: the lower bound (-200) is invalid: too low, expected between -100 and +100`,
+					),
+					Entry(
+						"lower bound greater than upper bound",
+						"20..10",
+						`value of FERRITE_RANGE (20..10) is invalid: the lower bound must not be greater than the upper bound`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(1, 2).
+							Required().
+							Value()
+
+						Expect(v).To(Equal(Range[int16]{1, 2}))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_RANGE is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the default value is invalid", func() {
+		It("panics if a bound exceeds the element limits", func() {
+			Expect(func() {
+				builder.
+					WithDefault(0, 200).
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_RANGE is invalid: default value: upper bound: too high, expected between -100 and +100`,
+			))
+		})
+	})
+
+	Describe("func Range.Contains()", func() {
+		DescribeTable(
+			"it returns true if the value is within the range",
+			func(v int, expect bool) {
+				r := Range[int]{Low: 10, High: 20}
+				Expect(r.Contains(v)).To(Equal(expect))
+			},
+			Entry("below", 9, false),
+			Entry("lower bound", 10, true),
+			Entry("within", 15, true),
+			Entry("upper bound", 20, true),
+			Entry("above", 21, false),
+		)
+	})
+
+	When("the element is a network port", func() {
+		It("rejects IANA service names", func() {
+			os.Setenv("FERRITE_RANGE", "http..https")
+
+			Expect(func() {
+				RangeOf("FERRITE_RANGE", "<desc>", NetworkPort("", "")).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_RANGE (http..https) is invalid: the lower bound (http) is invalid: unrecognized uint16 syntax`,
+			))
+		})
+	})
+})
+
+func ExampleRangeOf_required() {
+	defer example()()
+
+	v := ferrite.
+		RangeOf(
+			"FERRITE_RANGE",
+			"example range variable",
+			ferrite.Duration("", ""),
+		).
+		Required()
+
+	os.Setenv("FERRITE_RANGE", "100ms..5s")
+	ferrite.Init()
+
+	fmt.Println("range is", v.Value().Low, "to", v.Value().High)
+	fmt.Println("contains 1s:", v.Value().Contains(1*time.Second))
+
+	// Output:
+	// range is 100ms to 5s
+	// contains 1s: true
+}
+
+func ExampleRangeOf_default() {
+	defer example()()
+
+	v := ferrite.
+		RangeOf(
+			"FERRITE_RANGE",
+			"example range variable",
+			ferrite.NetworkPort("", ""),
+		).
+		WithDefault(30000, 32767).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("range is", v.Value().Low, "to", v.Value().High)
+
+	// Output:
+	// range is 30000 to 32767
+}
+
+func ExampleRangeOf_optional() {
+	defer example()()
+
+	v := ferrite.
+		RangeOf(
+			"FERRITE_RANGE",
+			"example range variable",
+			ferrite.Unsigned[uint]("", ""),
+		).
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("range is", x.Low, "to", x.High)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleRangeOf_deprecated() {
+	defer example()()
+
+	v := ferrite.
+		RangeOf(
+			"FERRITE_RANGE",
+			"example range variable",
+			ferrite.Unsigned[uint]("", ""),
+		).
+		Deprecated()
+
+	os.Setenv("FERRITE_RANGE", "30000-32767")
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("range is", x.Low, "to", x.High)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_RANGE  example range variable  [ <string> ]  ⚠ deprecated variable set to 30000-32767, equivalent to 30000..32767
+	//
+	// range is 30000 to 32767
+}
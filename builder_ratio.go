@@ -0,0 +1,257 @@
+package ferrite
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/inflect"
+	"github.com/dogmatiq/ferrite/internal/maybe"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// Ratio configures an environment variable as a ratio between 0 and 1, such as
+// a sampling rate.
+//
+// The value may be specified as a decimal fraction, such as "0.25", or as a
+// percentage, such as "25%". In either case the variable's value is a float64
+// between 0 and 1, inclusive.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func Ratio(name, desc string) *RatioBuilder {
+	return newRatioBuilder(name, desc, false)
+}
+
+// Percent configures an environment variable as a ratio between 0 and 1 that is
+// typically expressed as a percentage.
+//
+// It is equivalent to [Ratio], except that values are rendered as percentages
+// in the documentation and validation output.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func Percent(name, desc string) *RatioBuilder {
+	return newRatioBuilder(name, desc, true)
+}
+
+func newRatioBuilder(name, desc string, percent bool) *RatioBuilder {
+	b := &RatioBuilder{
+		schema: variable.TypedNumeric[float64]{
+			NativeMin: maybe.Some(0.0),
+			NativeMax: maybe.Some(1.0),
+		},
+		marshaler: ratioMarshaler{
+			percent: percent,
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// RatioBuilder builds a specification for a ratio between 0 and 1.
+type RatioBuilder struct {
+	schema    variable.TypedNumeric[float64]
+	builder   variable.TypedSpecBuilder[float64]
+	marshaler ratioMarshaler
+}
+
+var _ isBuilderOf[
+	float64,
+	float64,
+	*RatioBuilder,
+]
+
+var _ isFinalizedBuilder[*RatioBuilder]
+
+// WithDefault sets the default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *RatioBuilder) WithDefault(v float64) *RatioBuilder {
+	b.builder.Default(v)
+	return b
+}
+
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *RatioBuilder) WithDefaultFunc(
+	fn func() (v float64, ok bool),
+	from ...AnyVariableSet,
+) *RatioBuilder {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *RatioBuilder) WithDefaultIn(p *Profile, v float64) *RatioBuilder {
+	b.builder.ProfileDefault(p.profile, v)
+	return b
+}
+
+// WithExample adds an example value to the variable's documentation.
+func (b *RatioBuilder) WithExample(v float64, desc string) *RatioBuilder {
+	b.builder.NormativeExample(v, desc)
+	return b
+}
+
+// WithMinimum sets the minimum acceptable value of the variable.
+//
+// v must be between 0 and 1.
+func (b *RatioBuilder) WithMinimum(v float64) *RatioBuilder {
+	b.schema.NativeMin = maybe.Some(v)
+	return b
+}
+
+// WithMaximum sets the maximum acceptable value of the variable.
+//
+// v must be between 0 and 1.
+func (b *RatioBuilder) WithMaximum(v float64) *RatioBuilder {
+	b.schema.NativeMax = maybe.Some(v)
+	return b
+}
+
+// WithPrecision rounds the value to the given number of digits after the
+// decimal point.
+//
+// If the variable was configured using [Percent], the precision applies to the
+// percentage; otherwise it applies to the decimal fraction.
+func (b *RatioBuilder) WithPrecision(digits int) *RatioBuilder {
+	if digits < 0 {
+		panic("precision must not be negative")
+	}
+
+	b.marshaler.precision = maybe.Some(digits)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *RatioBuilder) Required(options ...RequiredOption) Required[float64] {
+	b.finalize()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *RatioBuilder) Optional(options ...OptionalOption) Optional[float64] {
+	b.finalize()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *RatioBuilder) Deprecated(options ...DeprecatedOption) Deprecated[float64] {
+	b.finalize()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+// finalize documents the ratio syntax and rounding precision.
+func (b *RatioBuilder) finalize() {
+	b.schema.Marshaler = b.marshaler
+
+	doc := b.builder.Documentation().
+		Summary("Ratio syntax").
+		Paragraph(
+			"Ratios may be specified as a decimal fraction between `0` and `1`, such as `0.25`,",
+			"or as a percentage between `%s` and `%s`, such as `%s`.",
+		).
+		Format("0%", "100%", "25%")
+
+	if p, ok := b.marshaler.precision.Get(); ok {
+		unit := "decimal fraction"
+		if b.marshaler.percent {
+			unit = "percentage"
+		}
+
+		doc = doc.
+			Paragraph(
+				"Values are rounded such that the %s has %d %s after the decimal point.",
+			).
+			Format(unit, p, inflect.Pluralize("digit", p))
+	}
+
+	doc.Done()
+}
+
+type ratioMarshaler struct {
+	percent   bool
+	precision maybe.Value[int]
+}
+
+func (m ratioMarshaler) Marshal(v float64) (variable.Literal, error) {
+	if math.IsNaN(v) || v < 0 || v > 1 {
+		if m.percent {
+			return variable.Literal{}, errors.New("expected a percentage between 0% and 100%")
+		}
+		return variable.Literal{}, errors.New("expected a ratio between 0 and 1")
+	}
+
+	digits := -1
+	if p, ok := m.precision.Get(); ok {
+		digits = p
+	}
+
+	if m.percent {
+		return variable.Literal{
+			String: strconv.FormatFloat(percentOf(v), 'f', digits, 64) + "%",
+		}, nil
+	}
+
+	return variable.Literal{
+		String: strconv.FormatFloat(v, 'f', digits, 64),
+	}, nil
+}
+
+func (m ratioMarshaler) Unmarshal(v variable.Literal) (float64, error) {
+	s, isPercent := strings.CutSuffix(v.String, "%")
+
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, errors.New("expected a decimal fraction, such as 0.25, or a percentage, such as 25%")
+	}
+
+	if isPercent {
+		n /= 100
+	}
+
+	if p, ok := m.precision.Get(); ok {
+		if m.percent {
+			n = roundFloat(percentOf(n), p) / 100
+		} else {
+			n = roundFloat(n, p)
+		}
+	}
+
+	return n, nil
+}
+
+// percentOf returns v as a percentage.
+//
+// The result is rounded to 15 significant digits to avoid representation
+// errors introduced by the multiplication, such that 0.29 is rendered as 29%
+// and not 28.999999999999996%.
+func percentOf(v float64) float64 {
+	p, err := strconv.ParseFloat(
+		strconv.FormatFloat(v*100, 'g', 15, 64),
+		64,
+	)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
@@ -0,0 +1,259 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type RatioBuilder", func() {
+	var builder *RatioBuilder
+
+	BeforeEach(func() {
+		builder = Ratio("FERRITE_RATIO", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Ratio("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Ratio("FERRITE_RATIO", "").Optional()
+		}).To(PanicWith("specification for FERRITE_RATIO is invalid: variable description must not be empty"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"returns the value",
+					func(value string, expect float64) {
+						os.Setenv("FERRITE_RATIO", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(BeNumerically("~", expect, 1e-9))
+					},
+					Entry("zero", "0", 0.0),
+					Entry("one", "1", 1.0),
+					Entry("decimal fraction", "0.25", 0.25),
+					Entry("percentage", "25%", 0.25),
+					Entry("fractional percentage", "12.5%", 0.125),
+					Entry("one hundred percent", "100%", 1.0),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_RATIO", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"unrecognized syntax",
+						"quarter",
+						`value of FERRITE_RATIO (quarter) is invalid: expected a decimal fraction, such as 0.25, or a percentage, such as 25%`,
+					),
+					Entry(
+						"greater than one",
+						"1.5",
+						`value of FERRITE_RATIO (1.5) is invalid: too high, expected between 0 and 1`,
+					),
+					Entry(
+						"greater than one hundred percent",
+						"150%",
+						`value of FERRITE_RATIO (150%) is invalid: too high, expected between 0 and 1`,
+					),
+					Entry(
+						"negative",
+						"-0.1",
+						`value of FERRITE_RATIO (-0.1) is invalid: too low, expected between 0 and 1`,
+					),
+					Entry(
+						"NaN",
+						"NaN",
+						`value of FERRITE_RATIO (NaN) is invalid: expected a finite number`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_RATIO is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v, ok := builder.
+							WithDefault(0.1).
+							Optional().
+							Value()
+
+						Expect(ok).To(BeTrue())
+						Expect(v).To(Equal(0.1))
+					})
+				})
+			})
+		})
+	})
+
+	When("the minimum is out of range", func() {
+		It("panics", func() {
+			Expect(func() {
+				builder.
+					WithMinimum(-1).
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_RATIO is invalid: minimum value: expected a ratio between 0 and 1`,
+			))
+		})
+	})
+
+	When("the minimum is greater than the maximum", func() {
+		It("panics", func() {
+			Expect(func() {
+				builder.
+					WithMinimum(0.8).
+					WithMaximum(0.2).
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_RATIO is invalid: minimum value (0.8) must not be greater than the maximum value (0.2)`,
+			))
+		})
+	})
+
+	When("the value is rendered as a percentage", func() {
+		It("panics if the maximum is out of range", func() {
+			Expect(func() {
+				Percent("FERRITE_RATIO", "<desc>").
+					WithMaximum(2).
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_RATIO is invalid: maximum value: expected a percentage between 0% and 100%`,
+			))
+		})
+
+		It("rounds the percentage to the precision", func() {
+			os.Setenv("FERRITE_RATIO", "0.12345")
+
+			v := Percent("FERRITE_RATIO", "<desc>").
+				WithPrecision(1).
+				Required().
+				Value()
+
+			Expect(v).To(BeNumerically("~", 0.123, 1e-9))
+		})
+	})
+})
+
+func ExampleRatio_required() {
+	defer example()()
+
+	v := ferrite.
+		Ratio("FERRITE_RATIO", "example ratio variable").
+		Required()
+
+	os.Setenv("FERRITE_RATIO", "25%")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 0.25
+}
+
+func ExampleRatio_default() {
+	defer example()()
+
+	v := ferrite.
+		Ratio("FERRITE_RATIO", "example ratio variable").
+		WithDefault(0.1).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 0.1
+}
+
+func ExampleRatio_optional() {
+	defer example()()
+
+	v := ferrite.
+		Ratio("FERRITE_RATIO", "example ratio variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExamplePercent() {
+	defer example()()
+
+	v := ferrite.
+		Percent("FERRITE_PERCENT", "example percentage variable").
+		Deprecated()
+
+	os.Setenv("FERRITE_PERCENT", "0.125")
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_PERCENT  example percentage variable  [ 0% .. 100% ]  ⚠ deprecated variable set to 0.125, equivalent to 12.5%
+	//
+	// value is 0.125
+}
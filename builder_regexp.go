@@ -0,0 +1,274 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/maybe"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// Regexp configures an environment variable as a regular expression.
+//
+// The value must use the RE2 syntax accepted by Go's [regexp] package.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func Regexp(name, desc string) *RegexpBuilder {
+	b := &RegexpBuilder{
+		schema: variable.TypedOther[*regexp.Regexp]{
+			Marshaler: regexpMarshaler{},
+		},
+		examples: []variable.TypedExample[string]{
+			{
+				Native:      `^/api/v[0-9]+/`,
+				Description: "paths that begin with a versioned API prefix",
+			},
+			{
+				Native:      `(?i)error|warn`,
+				Description: "a case-insensitive match of either word",
+			},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.Documentation().
+		Summary("Regular expression syntax").
+		Paragraph(
+			"Regular expressions are specified using the RE2 syntax, as implemented by Go's `regexp` package.",
+			"Flags such as case-insensitivity can be enabled inline using a group such as `(?i)`.",
+		).
+		Format().
+		Paragraph(
+			"Lookaround assertions and backreferences are not supported.",
+		).
+		Format().
+		Done()
+
+	return b
+}
+
+// RegexpBuilder builds a specification for a regular expression variable.
+type RegexpBuilder struct {
+	schema      variable.TypedOther[*regexp.Regexp]
+	builder     variable.TypedSpecBuilder[*regexp.Regexp]
+	def         maybe.Value[string]
+	profileDefs []profileDefault[string]
+	examples    []variable.TypedExample[string]
+}
+
+var _ isBuilderOf[
+	*regexp.Regexp,
+	string,
+	*RegexpBuilder,
+]
+
+// WithDefault sets the default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *RegexpBuilder) WithDefault(v string) *RegexpBuilder {
+	b.def = maybe.Some(v)
+	return b
+}
+
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *RegexpBuilder) WithDefaultFunc(
+	fn func() (v *regexp.Regexp, ok bool),
+	from ...AnyVariableSet,
+) *RegexpBuilder {
+	b.def = maybe.None[string]()
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *RegexpBuilder) WithDefaultIn(p *Profile, v string) *RegexpBuilder {
+	b.profileDefs = append(b.profileDefs, profileDefault[string]{p, v})
+	return b
+}
+
+// WithExample adds an example value to the variable's documentation.
+func (b *RegexpBuilder) WithExample(v string, desc string) *RegexpBuilder {
+	b.examples = append(b.examples, variable.TypedExample[string]{
+		Native:      v,
+		Description: desc,
+		IsNormative: true,
+	})
+	return b
+}
+
+// WithAnchoring requires the regular expression to match the entire input.
+//
+// The pattern is compiled as though it were wrapped in `^(?:` and `)$`. The
+// wrapping is not included in the canonical representation of the value.
+func (b *RegexpBuilder) WithAnchoring() *RegexpBuilder {
+	if b.schema.Marshaler.(regexpMarshaler).anchored {
+		return b
+	}
+
+	b.schema.Marshaler = regexpMarshaler{anchored: true}
+	b.builder.Documentation().
+		Paragraph(
+			"The regular expression is anchored at both ends;",
+			"it must match the entire input, not just a substring of it.",
+		).
+		Format().
+		Important().
+		Done()
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the environment variable value after it is parsed. If fn
+// returns false the value is considered invalid.
+func (b *RegexpBuilder) WithConstraint(
+	desc string,
+	fn func(*regexp.Regexp) bool,
+) *RegexpBuilder {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *RegexpBuilder) Required(options ...RequiredOption) Required[*regexp.Regexp] {
+	b.compileLiterals()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *RegexpBuilder) Optional(options ...OptionalOption) Optional[*regexp.Regexp] {
+	b.compileLiterals()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *RegexpBuilder) Deprecated(options ...DeprecatedOption) Deprecated[*regexp.Regexp] {
+	b.compileLiterals()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+// compileLiterals compiles the default value and examples using the final
+// marshaler configuration, so that options such as [RegexpBuilder.WithAnchoring]
+// apply regardless of the order in which the builder methods are called.
+func (b *RegexpBuilder) compileLiterals() {
+	m := b.schema.Marshaler
+
+	if v, ok := b.def.Get(); ok {
+		b.builder.Default(mustParseRegexp(m, v))
+	}
+
+	for _, d := range b.profileDefs {
+		b.builder.ProfileDefault(d.profile.profile, mustParseRegexp(m, d.value))
+	}
+
+	for _, eg := range b.examples {
+		if eg.IsNormative {
+			b.builder.NormativeExample(mustParseRegexp(m, eg.Native), eg.Description)
+		} else {
+			b.builder.NonNormativeExample(mustParseRegexp(m, eg.Native), eg.Description)
+		}
+	}
+}
+
+const (
+	regexpAnchorPrefix = `^(?:`
+	regexpAnchorSuffix = `)$`
+)
+
+type regexpMarshaler struct {
+	anchored bool
+}
+
+func (m regexpMarshaler) Marshal(v *regexp.Regexp) (variable.Literal, error) {
+	s := v.String()
+
+	if m.anchored {
+		s = strings.TrimPrefix(s, regexpAnchorPrefix)
+		s = strings.TrimSuffix(s, regexpAnchorSuffix)
+	}
+
+	return variable.Literal{
+		String: s,
+	}, nil
+}
+
+func (m regexpMarshaler) Unmarshal(v variable.Literal) (*regexp.Regexp, error) {
+	// Always compile the pattern as given first, so that the position in any
+	// syntax error refers to the value exactly as the user supplied it.
+	re, err := regexp.Compile(v.String)
+	if err != nil {
+		return nil, explainRegexpError(v.String, err)
+	}
+
+	if m.anchored {
+		return regexp.Compile(regexpAnchorPrefix + v.String + regexpAnchorSuffix)
+	}
+
+	return re, nil
+}
+
+// explainRegexpError returns a more user-friendly error message for errors
+// returned by [regexp.Compile].
+func explainRegexpError(pattern string, err error) error {
+	var synErr *syntax.Error
+	if !errors.As(err, &synErr) {
+		return err
+	}
+
+	// The syntax error does not include an offset, but it does include the
+	// offending portion of the expression, which we can locate within the
+	// original pattern. An empty expression indicates a problem at the end of
+	// the pattern, such as a trailing backslash.
+	if synErr.Expr == "" {
+		return fmt.Errorf(
+			"%s at position %d",
+			synErr.Code,
+			len(pattern),
+		)
+	}
+
+	// The position is only reported if the offending portion occurs exactly
+	// once, otherwise we can't tell which occurrence caused the error.
+	if strings.Count(pattern, synErr.Expr) != 1 {
+		return fmt.Errorf(
+			"%s (%s)",
+			synErr.Code,
+			synErr.Expr,
+		)
+	}
+
+	return fmt.Errorf(
+		"%s at position %d (%s)",
+		synErr.Code,
+		strings.Index(pattern, synErr.Expr)+1,
+		synErr.Expr,
+	)
+}
+
+func mustParseRegexp(m variable.Marshaler[*regexp.Regexp], v string) *regexp.Regexp {
+	re, err := m.Unmarshal(variable.Literal{String: v})
+	if err != nil {
+		panic(err)
+	}
+	return re
+}
@@ -0,0 +1,40 @@
+package ferrite
+
+import (
+	"regexp/syntax"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable(
+	"explainRegexpError",
+	func(pattern, expr, expect string) {
+		err := explainRegexpError(
+			pattern,
+			&syntax.Error{
+				Code: syntax.ErrInvalidRepeatOp,
+				Expr: expr,
+			},
+		)
+		Expect(err).To(MatchError(expect))
+	},
+	Entry(
+		"expression occurs once",
+		"a**",
+		"**",
+		"invalid nested repetition operator at position 2 (**)",
+	),
+	Entry(
+		"expression occurs more than once",
+		"a**b**",
+		"**",
+		"invalid nested repetition operator (**)",
+	),
+	Entry(
+		"expression does not occur in the pattern",
+		"a**",
+		"b**",
+		"invalid nested repetition operator (b**)",
+	),
+)
@@ -0,0 +1,293 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type RegexpBuilder", func() {
+	var builder *RegexpBuilder
+
+	BeforeEach(func() {
+		builder = Regexp("FERRITE_REGEXP", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Regexp("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Regexp("FERRITE_REGEXP", "").Optional()
+		}).To(PanicWith("specification for FERRITE_REGEXP is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the default value is not a valid regular expression", func() {
+		Expect(func() {
+			builder.
+				WithDefault("(abc").
+				Required()
+		}).To(PanicWith(MatchError("missing closing ) at position 1 ((abc)")))
+	})
+
+	When("the variable is required", func() {
+		When("the value is not empty", func() {
+			Describe("func Value()", func() {
+				It("returns the compiled regular expression", func() {
+					os.Setenv("FERRITE_REGEXP", "^[a-z]+$")
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v.String()).To(Equal("^[a-z]+$"))
+					Expect(v.MatchString("abc")).To(BeTrue())
+					Expect(v.MatchString("ABC")).To(BeFalse())
+				})
+			})
+		})
+
+		When("the value is invalid", func() {
+			DescribeTable(
+				"it panics with the position of the syntax error",
+				func(value, expect string) {
+					os.Setenv("FERRITE_REGEXP", value)
+
+					Expect(func() {
+						builder.
+							Required().
+							Value()
+					}).To(PanicWith(expect))
+				},
+				Entry(
+					"missing closing parenthesis",
+					"(abc",
+					`value of FERRITE_REGEXP ('(abc') is invalid: missing closing ) at position 1 ((abc)`,
+				),
+				Entry(
+					"invalid repetition",
+					"a**",
+					`value of FERRITE_REGEXP ('a**') is invalid: invalid nested repetition operator at position 2 (**)`,
+				),
+				Entry(
+					"trailing backslash",
+					`abc\`,
+					`value of FERRITE_REGEXP ('abc\') is invalid: trailing backslash at end of expression at position 4`,
+				),
+				Entry(
+					"ambiguous position",
+					"((a{1000}){1000}){1000}",
+					`value of FERRITE_REGEXP ('((a{1000}){1000}){1000}') is invalid: invalid repeat count ({1000})`,
+				),
+			)
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("^[a-z]+$").
+							Required().
+							Value()
+
+						Expect(v.String()).To(Equal("^[a-z]+$"))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_REGEXP is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is not empty", func() {
+			Describe("func Value()", func() {
+				It("returns the compiled regular expression", func() {
+					os.Setenv("FERRITE_REGEXP", "^[a-z]+$")
+
+					v, ok := builder.
+						Optional().
+						Value()
+
+					Expect(ok).To(BeTrue())
+					Expect(v.String()).To(Equal("^[a-z]+$"))
+				})
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the regular expression is anchored", func() {
+		It("only matches the entire input", func() {
+			os.Setenv("FERRITE_REGEXP", "[a-z]+")
+
+			v := builder.
+				WithAnchoring().
+				Required().
+				Value()
+
+			Expect(v.MatchString("abc")).To(BeTrue())
+			Expect(v.MatchString("abc123")).To(BeFalse())
+		})
+
+		It("anchors the default value even if it is set first", func() {
+			v := builder.
+				WithDefault("a|b").
+				WithAnchoring().
+				Required().
+				Value()
+
+			Expect(v.MatchString("a")).To(BeTrue())
+			Expect(v.MatchString("ab")).To(BeFalse())
+		})
+	})
+})
+
+func ExampleRegexp_required() {
+	defer example()()
+
+	v := ferrite.
+		Regexp("FERRITE_REGEXP", "example regular expression variable").
+		Required()
+
+	os.Setenv("FERRITE_REGEXP", "^[a-z]+$")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is ^[a-z]+$
+}
+
+func ExampleRegexp_default() {
+	defer example()()
+
+	v := ferrite.
+		Regexp("FERRITE_REGEXP", "example regular expression variable").
+		WithDefault("^[a-z]+$").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is ^[a-z]+$
+}
+
+func ExampleRegexp_optional() {
+	defer example()()
+
+	v := ferrite.
+		Regexp("FERRITE_REGEXP", "example regular expression variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleRegexp_anchored() {
+	defer example()()
+
+	v := ferrite.
+		Regexp("FERRITE_REGEXP", "example regular expression variable").
+		WithAnchoring().
+		Required()
+
+	os.Setenv("FERRITE_REGEXP", "[a-z]+")
+	ferrite.Init()
+
+	fmt.Println("abc matches:", v.Value().MatchString("abc"))
+	fmt.Println("abc123 matches:", v.Value().MatchString("abc123"))
+
+	// Output:
+	// abc matches: true
+	// abc123 matches: false
+}
+
+func ExampleRegexp_invalid() {
+	defer example()()
+
+	ferrite.
+		Regexp("FERRITE_REGEXP", "example regular expression variable").
+		Required()
+
+	os.Setenv("FERRITE_REGEXP", "[a-z")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_REGEXP  example regular expression variable    <string>    ✗ set to '[a-z', missing closing ] at position 1 ([a-z)
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleRegexp_deprecated() {
+	defer example()()
+
+	v := ferrite.
+		Regexp("FERRITE_REGEXP", "example regular expression variable").
+		Deprecated()
+
+	os.Setenv("FERRITE_REGEXP", "^[a-z]+$")
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_REGEXP  example regular expression variable  [ <string> ]  ⚠ deprecated variable set to '^[a-z]+$'
+	//
+	// value is ^[a-z]+$
+}
@@ -1,8 +1,10 @@
 package ferrite
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/dogmatiq/ferrite/internal/maybe"
 	"github.com/dogmatiq/ferrite/internal/reflectx"
@@ -23,32 +25,15 @@ func Signed[T constraints.Signed](name, desc string) *SignedBuilder[T] {
 
 	b.builder.Name(name)
 	b.builder.Description(desc)
-	b.builder.Documentation().
-		Summary("Signed integer syntax").
-		Paragraph(
-			"Signed integers can only be specified using decimal notation.",
-			"A leading positive sign (`+`) is **OPTIONAL**.",
-			"A leading negative sign (`-`) is **REQUIRED** in order to specify a negative value.",
-		).
-		Format().
-		Paragraph(
-			"Internally, the `%s` variable is represented using a signed %d-bit integer type (`%s`);",
-			"any value that overflows this data-type is invalid.",
-		).
-		Format(
-			name,
-			reflectx.BitSize[T](),
-			reflectx.KindOf[T](),
-		).
-		Done()
 
 	return b
 }
 
 // SignedBuilder builds a specification for a signed integer value.
 type SignedBuilder[T constraints.Signed] struct {
-	schema  variable.TypedNumeric[T]
-	builder variable.TypedSpecBuilder[T]
+	schema    variable.TypedNumeric[T]
+	builder   variable.TypedSpecBuilder[T]
+	marshaler signedMarshaler[T]
 }
 
 var _ isBuilderOf[
@@ -57,6 +42,8 @@ var _ isBuilderOf[
 	*SignedBuilder[int],
 ]
 
+var _ isFinalizedBuilder[*SignedBuilder[int]]
+
 // WithDefault sets the default value of the variable.
 //
 // It is used when the environment variable is undefined or empty.
@@ -65,6 +52,33 @@ func (b *SignedBuilder[T]) WithDefault(v T) *SignedBuilder[T] {
 	return b
 }
 
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *SignedBuilder[T]) WithDefaultFunc(
+	fn func() (v T, ok bool),
+	from ...AnyVariableSet,
+) *SignedBuilder[T] {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *SignedBuilder[T]) WithDefaultIn(p *Profile, v T) *SignedBuilder[T] {
+	b.builder.ProfileDefault(p.profile, v)
+	return b
+}
+
 // WithExample adds an example value to the variable's documentation.
 func (b *SignedBuilder[T]) WithExample(v T, desc string) *SignedBuilder[T] {
 	b.builder.NormativeExample(v, desc)
@@ -83,25 +97,91 @@ func (b *SignedBuilder[T]) WithMaximum(v T) *SignedBuilder[T] {
 	return b
 }
 
+// WithMultipleOf requires the value to be an exact multiple of n.
+//
+// n must be positive.
+func (b *SignedBuilder[T]) WithMultipleOf(n T) *SignedBuilder[T] {
+	b.schema.NativeMultipleOf = maybe.Some(n)
+	return b
+}
+
+// WithPowerOfTwo requires the value to be a power of two, such as 1, 2, 4 or 8.
+func (b *SignedBuilder[T]) WithPowerOfTwo() *SignedBuilder[T] {
+	b.schema.PowerOfTwo = true
+	return b
+}
+
+// WithBasePrefixes allows the value to be specified in hexadecimal, octal or
+// binary notation, using the `0x`, `0o` and `0b` prefixes, respectively.
+//
+// It also allows underscores to be used to separate digits, such as
+// `1_000_000`. The syntax is the same as that used for integer literals in Go
+// source code, except that a leading zero alone is not accepted as an octal
+// prefix.
+func (b *SignedBuilder[T]) WithBasePrefixes() *SignedBuilder[T] {
+	b.marshaler.basePrefixes = true
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *SignedBuilder[T]) Required(options ...RequiredOption) Required[T] {
+	b.finalize()
 	return required(b.schema, &b.builder, options...)
 }
 
 // Optional completes the build process and registers an optional variable with
 // Ferrite's validation system.
 func (b *SignedBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
+	b.finalize()
 	return optional(b.schema, &b.builder, options...)
 }
 
 // Deprecated completes the build process and registers a deprecated variable
 // with Ferrite's validation system.
 func (b *SignedBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
+	b.finalize()
 	return deprecated(b.schema, &b.builder, options...)
 }
 
-type signedMarshaler[T constraints.Signed] struct{}
+// finalize documents the integer syntax accepted by the marshaler.
+func (b *SignedBuilder[T]) finalize() {
+	b.schema.Marshaler = b.marshaler
+
+	syntax := []string{
+		"Signed integers can only be specified using decimal notation.",
+		"A leading positive sign (`+`) is **OPTIONAL**.",
+		"A leading negative sign (`-`) is **REQUIRED** in order to specify a negative value.",
+	}
+
+	if b.marshaler.basePrefixes {
+		syntax = []string{
+			"Signed integers may be specified using decimal (base-10), hexadecimal (`0x`), octal (`0o`) or binary (`0b`) notation, such as `255`, `0xff`, `0o377` or `0b11111111`.",
+			"Underscores may be used to separate digits, such as `1_000_000`.",
+			"A leading positive sign (`+`) is **OPTIONAL**.",
+			"A leading negative sign (`-`) is **REQUIRED** in order to specify a negative value.",
+		}
+	}
+
+	b.builder.Documentation().
+		Summary("Signed integer syntax").
+		Paragraph(syntax...).
+		Format().
+		Paragraph(
+			"Internally, the `%s` variable is represented using a signed %d-bit integer type (`%s`);",
+			"any value that overflows this data-type is invalid.",
+		).
+		Format(
+			b.builder.Peek().Name(),
+			reflectx.BitSize[T](),
+			reflectx.KindOf[T](),
+		).
+		Done()
+}
+
+type signedMarshaler[T constraints.Signed] struct {
+	basePrefixes bool
+}
 
 func (signedMarshaler[T]) Marshal(v T) (variable.Literal, error) {
 	return variable.Literal{
@@ -109,11 +189,37 @@ func (signedMarshaler[T]) Marshal(v T) (variable.Literal, error) {
 	}, nil
 }
 
-func (signedMarshaler[T]) Unmarshal(v variable.Literal) (T, error) {
-	n, err := strconv.ParseInt(v.String, 10, reflectx.BitSize[T]())
+func (m signedMarshaler[T]) Unmarshal(v variable.Literal) (T, error) {
+	base := 10
+
+	if m.basePrefixes {
+		if err := checkLegacyOctalSyntax(v.String); err != nil {
+			return 0, err
+		}
+		base = 0
+	}
+
+	n, err := strconv.ParseInt(v.String, base, reflectx.BitSize[T]())
 	return T(n), variable.UnwrapNumericParseError(err, formatSigned[T])
 }
 
 func formatSigned[T constraints.Signed](v T) string {
 	return fmt.Sprintf("%+d", v)
 }
+
+// checkLegacyOctalSyntax returns an error if s is an integer literal that uses
+// a leading zero (without a letter) to indicate octal notation.
+//
+// strconv.ParseInt() and strconv.ParseUint() accept this syntax when the base
+// is 0, but it is easily mistaken for a decimal number with a leading zero.
+func checkLegacyOctalSyntax(s string) error {
+	digits := strings.TrimLeft(s, "+-")
+
+	if len(digits) > 1 && digits[0] == '0' {
+		if c := digits[1]; c == '_' || (c >= '0' && c <= '9') {
+			return errors.New("leading zeros are not permitted, use the 0o prefix for octal notation")
+		}
+	}
+
+	return nil
+}
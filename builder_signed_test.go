@@ -240,6 +240,133 @@ var _ = Describe("type SignedBuilder", func() {
 			))
 		})
 	})
+
+	When("the value is not a multiple of the required number", func() {
+		It("panics", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_SIGNED", "10")
+
+				builder.
+					WithMultipleOf(4).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_SIGNED (10) is invalid: expected a multiple of +4`,
+			))
+		})
+	})
+
+	When("the multiple-of value is not positive", func() {
+		It("panics", func() {
+			Expect(func() {
+				builder.
+					WithMultipleOf(0).
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_SIGNED is invalid: multiple-of value must be positive`,
+			))
+		})
+	})
+
+	When("the minimum limit is greater than the maximum limit", func() {
+		It("panics", func() {
+			Expect(func() {
+				builder.
+					WithMinimum(10).
+					WithMaximum(-10).
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_SIGNED is invalid: minimum value (+10) must not be greater than the maximum value (-10)`,
+			))
+		})
+	})
+
+	When("the value is not a power of two", func() {
+		DescribeTable(
+			"it panics",
+			func(value, expect string) {
+				os.Setenv("FERRITE_SIGNED", value)
+
+				Expect(func() {
+					builder.
+						WithPowerOfTwo().
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry("zero", "0", `value of FERRITE_SIGNED (0) is invalid: expected a power of two`),
+			Entry("not a power of two", "12", `value of FERRITE_SIGNED (12) is invalid: expected a power of two`),
+		)
+
+		It("accepts powers of two", func() {
+			os.Setenv("FERRITE_SIGNED", "64")
+
+			v := builder.
+				WithPowerOfTwo().
+				Required().
+				Value()
+
+			Expect(v).To(Equal(userDefinedSigned(64)))
+		})
+	})
+
+	When("base prefixes are enabled", func() {
+		DescribeTable(
+			"it returns the value",
+			func(value string, expect int) {
+				os.Setenv("FERRITE_SIGNED", value)
+
+				v := builder.
+					WithBasePrefixes().
+					Required().
+					Value()
+
+				Expect(v).To(Equal(userDefinedSigned(expect)))
+			},
+			Entry("decimal", "255", 255),
+			Entry("zero", "0", 0),
+			Entry("hexadecimal", "0xff", 255),
+			Entry("octal", "0o377", 255),
+			Entry("binary", "0b11111111", 255),
+			Entry("underscores", "1_000", 1000),
+		)
+
+		DescribeTable(
+			"it panics if the value is invalid",
+			func(value, expect string) {
+				os.Setenv("FERRITE_SIGNED", value)
+
+				Expect(func() {
+					builder.
+						WithBasePrefixes().
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"legacy octal",
+				"0377",
+				`value of FERRITE_SIGNED (0377) is invalid: leading zeros are not permitted, use the 0o prefix for octal notation`,
+			),
+			Entry(
+				"misplaced underscore",
+				"1__000",
+				`value of FERRITE_SIGNED (1__000) is invalid: unrecognized int16 syntax`,
+			),
+		)
+
+		It("does not accept prefixes when disabled", func() {
+			os.Setenv("FERRITE_SIGNED", "0xff")
+
+			Expect(func() {
+				builder.
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_SIGNED (0xff) is invalid: unrecognized int16 syntax`,
+			))
+		})
+	})
 })
 
 func ExampleSigned_required() {
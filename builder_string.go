@@ -1,6 +1,8 @@
 package ferrite
 
 import (
+	"regexp"
+
 	"github.com/dogmatiq/ferrite/internal/maybe"
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
@@ -47,6 +49,33 @@ func (b *StringBuilder[T]) WithDefault(v T) *StringBuilder[T] {
 	return b
 }
 
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *StringBuilder[T]) WithDefaultFunc(
+	fn func() (v T, ok bool),
+	from ...AnyVariableSet,
+) *StringBuilder[T] {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *StringBuilder[T]) WithDefaultIn(p *Profile, v T) *StringBuilder[T] {
+	b.builder.ProfileDefault(p.profile, v)
+	return b
+}
+
 // WithExample adds an example value to the variable's documentation.
 func (b *StringBuilder[T]) WithExample(v T, desc string) *StringBuilder[T] {
 	b.builder.NormativeExample(v, desc)
@@ -76,6 +105,47 @@ func (b *StringBuilder[T]) WithLength(n int) *StringBuilder[T] {
 	return b
 }
 
+// WithPattern requires the variable's value to match the regular expression re.
+//
+// The value only needs to contain a match for re. Use the `^` and `$` anchors
+// to require the entire value to match.
+func (b *StringBuilder[T]) WithPattern(re *regexp.Regexp) *StringBuilder[T] {
+	b.schema.StringRules = append(
+		b.schema.StringRules,
+		variable.PatternRule{Pattern: re},
+	)
+	return b
+}
+
+// WithPrefix requires the variable's value to begin with the given prefix.
+func (b *StringBuilder[T]) WithPrefix(prefix string) *StringBuilder[T] {
+	b.schema.StringRules = append(
+		b.schema.StringRules,
+		variable.PrefixRule{Prefix: prefix},
+	)
+	return b
+}
+
+// WithCharset requires every character in the variable's value to be a member
+// of the given character set.
+func (b *StringBuilder[T]) WithCharset(c Charset) *StringBuilder[T] {
+	b.schema.StringRules = append(
+		b.schema.StringRules,
+		variable.CharsetRule{Charset: variable.Charset(c)},
+	)
+	return b
+}
+
+// WithNoWhitespace requires the variable's value to not contain any whitespace
+// characters.
+func (b *StringBuilder[T]) WithNoWhitespace() *StringBuilder[T] {
+	b.schema.StringRules = append(
+		b.schema.StringRules,
+		variable.NoWhitespaceRule{},
+	)
+	return b
+}
+
 // WithConstraint adds a constraint to the variable.
 //
 // fn is called with the environment variable value after it is parsed. If fn
@@ -97,6 +167,16 @@ func (b *StringBuilder[T]) WithSensitiveContent() *StringBuilder[T] {
 	return b
 }
 
+// WithNormalizers adds normalizers that transform the variable's value before
+// it is parsed and validated.
+//
+// Normalizers are applied in the order they are given. The original value is
+// still shown in validation output.
+func (b *StringBuilder[T]) WithNormalizers(n ...Normalizer) *StringBuilder[T] {
+	addNormalizers(&b.builder, n)
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *StringBuilder[T]) Required(options ...RequiredOption) Required[T] {
@@ -114,3 +194,19 @@ func (b *StringBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
 func (b *StringBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
 	return deprecated(b.schema, &b.builder, options...)
 }
+
+// Charset is a set of characters that a string value may be restricted to
+// using [StringBuilder.WithCharset].
+type Charset int
+
+const (
+	// AlphanumericCharset is the set of ASCII letters and digits.
+	AlphanumericCharset = Charset(variable.CharsetAlphanumeric)
+
+	// HexCharset is the set of hexadecimal digits, in either case.
+	HexCharset = Charset(variable.CharsetHex)
+
+	// ASCIIPrintableCharset is the set of printable ASCII characters,
+	// including the space character.
+	ASCIIPrintableCharset = Charset(variable.CharsetASCIIPrintable)
+)
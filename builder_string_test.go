@@ -3,6 +3,7 @@ package ferrite_test
 import (
 	"fmt"
 	"os"
+	"regexp"
 
 	"github.com/dogmatiq/ferrite"
 	. "github.com/dogmatiq/ferrite"
@@ -220,6 +221,130 @@ var _ = Describe("type StringBuilder", func() {
 			})
 		})
 	})
+	It("panics if the prefix does not satisfy the other rules", func() {
+		Expect(func() {
+			builder.
+				WithPrefix("sk_").
+				WithCharset(AlphanumericCharset).
+				Required()
+		}).To(PanicWith(
+			`specification for FERRITE_STRING is invalid: prefix: unexpected character '_' at position 3, expected only ASCII letters and digits`,
+		))
+	})
+
+	When("the value does not satisfy a declarative rule", func() {
+		DescribeTable(
+			"it panics with a message describing the rule that failed",
+			func(value string, configure func(*StringBuilder[userDefinedString]), expect string) {
+				os.Setenv("FERRITE_STRING", value)
+				configure(builder)
+
+				Expect(func() {
+					builder.
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"pattern",
+				"ABC",
+				func(b *StringBuilder[userDefinedString]) {
+					b.WithPattern(regexp.MustCompile(`^[a-z]+$`))
+				},
+				`value of FERRITE_STRING (ABC) is invalid: expected a value matching the pattern /^[a-z]+$/`,
+			),
+			Entry(
+				"prefix",
+				"pk_123",
+				func(b *StringBuilder[userDefinedString]) {
+					b.WithPrefix("sk_")
+				},
+				`value of FERRITE_STRING (pk_123) is invalid: expected a value beginning with sk_`,
+			),
+			Entry(
+				"alphanumeric charset",
+				"abc-123",
+				func(b *StringBuilder[userDefinedString]) {
+					b.WithCharset(AlphanumericCharset)
+				},
+				`value of FERRITE_STRING (abc-123) is invalid: unexpected character '-' at position 4, expected only ASCII letters and digits`,
+			),
+			Entry(
+				"hex charset",
+				"c0ffeg",
+				func(b *StringBuilder[userDefinedString]) {
+					b.WithCharset(HexCharset)
+				},
+				`value of FERRITE_STRING (c0ffeg) is invalid: unexpected character 'g' at position 6, expected only hexadecimal digits`,
+			),
+			Entry(
+				"ASCII printable charset",
+				"café",
+				func(b *StringBuilder[userDefinedString]) {
+					b.WithCharset(ASCIIPrintableCharset)
+				},
+				`value of FERRITE_STRING ('café') is invalid: unexpected character 'é' at position 4, expected only printable ASCII characters`,
+			),
+			Entry(
+				"no whitespace",
+				"foo bar",
+				func(b *StringBuilder[userDefinedString]) {
+					b.WithNoWhitespace()
+				},
+				`value of FERRITE_STRING ('foo bar') is invalid: unexpected whitespace at position 4`,
+			),
+		)
+
+		It("reports the first rule that fails", func() {
+			os.Setenv("FERRITE_STRING", "abxyz")
+
+			Expect(func() {
+				builder.
+					WithPrefix("ab").
+					WithCharset(HexCharset).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_STRING (abxyz) is invalid: unexpected character 'x' at position 3, expected only hexadecimal digits`,
+			))
+		})
+	})
+
+	When("there are normalizers", func() {
+		It("applies the normalizers in order", func() {
+			os.Setenv("FERRITE_STRING", "  Cafe\u0301  ")
+
+			v := builder.
+				WithNormalizers(TrimSpace(), UnicodeNFC(), ToUpper()).
+				Required().
+				Value()
+
+			Expect(v).To(Equal(userDefinedString("CAF\u00c9")))
+		})
+
+		It("applies rules to the normalized value", func() {
+			os.Setenv("FERRITE_STRING", " abc ")
+
+			v := builder.
+				WithNoWhitespace().
+				WithNormalizers(TrimSpace()).
+				Required().
+				Value()
+
+			Expect(v).To(Equal(userDefinedString("abc")))
+		})
+
+		It("supports case folding", func() {
+			os.Setenv("FERRITE_STRING", "STRASSE Straße")
+
+			v := builder.
+				WithNormalizers(FoldCase()).
+				Required().
+				Value()
+
+			Expect(v).To(Equal(userDefinedString("strasse strasse")))
+		})
+	})
 })
 
 func ExampleString_required() {
@@ -272,6 +397,26 @@ func ExampleString_limits() {
 	// value is <value>
 }
 
+func ExampleString_rules() {
+	defer example()()
+
+	ferrite.
+		String("FERRITE_STRING", "example string variable").
+		WithPrefix("sk_").
+		WithNoWhitespace().
+		Required()
+
+	os.Setenv("FERRITE_STRING", "sk_abc 123")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_STRING  example string variable    <string> sk_* [^[:space:]]    ✗ set to 'sk_abc 123', unexpected whitespace at position 7
+	//
+	// <process exited with error code 1>
+}
+
 func ExampleString_optional() {
 	defer example()()
 
@@ -0,0 +1,478 @@
+package ferrite
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// TLS configures environment variables used to build a server-side TLS
+// configuration.
+//
+// prefix is the common prefix of the environment variable names, NOT the name
+// of an environment variable.
+//
+// The environment variables "<prefix>_CERT_FILE", "<prefix>_KEY_FILE",
+// "<prefix>_CA_FILE", "<prefix>_MIN_VERSION" and "<prefix>_CLIENT_AUTH" are
+// used to construct a [tls.Config].
+func TLS(prefix string) *TLSBuilder {
+	if prefix == "" {
+		panic("TLS variable prefix must not be empty")
+	}
+
+	b := &TLSBuilder{
+		prefix: prefix,
+		minVersionSchema: variable.TypedSet[uint16]{
+			Members: []variable.SetMember[uint16]{
+				{Value: tls.VersionTLS10, Description: "TLS 1.0, not recommended"},
+				{Value: tls.VersionTLS11, Description: "TLS 1.1, not recommended"},
+				{Value: tls.VersionTLS12, Description: "TLS 1.2"},
+				{Value: tls.VersionTLS13, Description: "TLS 1.3"},
+			},
+			ToLiteral: func(v uint16) variable.Literal {
+				return variable.Literal{String: tlsVersionNames[v]}
+			},
+		},
+		clientAuthSchema: variable.TypedSet[tls.ClientAuthType]{
+			Members: []variable.SetMember[tls.ClientAuthType]{
+				{Value: tls.NoClientCert, Description: "do not request a client certificate"},
+				{Value: tls.RequestClientCert, Description: "request a client certificate, but do not require or verify it"},
+				{Value: tls.RequireAnyClientCert, Description: "require a client certificate, but do not verify it"},
+				{Value: tls.VerifyClientCertIfGiven, Description: "verify the client certificate, if one is provided"},
+				{Value: tls.RequireAndVerifyClientCert, Description: "require and verify a client certificate"},
+			},
+			ToLiteral: func(v tls.ClientAuthType) variable.Literal {
+				return variable.Literal{String: tlsClientAuthNames[v]}
+			},
+			CaseInsensitive: true,
+		},
+	}
+
+	b.certBuilder.Name(prefix + "_CERT_FILE")
+	b.certBuilder.Description("TLS certificate file")
+	b.certBuilder.BuiltInConstraint(
+		"**MUST** refer to a file containing a PEM-encoded certificate",
+		func(ctx variable.ConstraintContext, v FileName) variable.ConstraintError {
+			if ctx != variable.ConstraintContextFinal {
+				return nil
+			}
+
+			_, err := readCertificates(v)
+			return err
+		},
+	)
+	b.certBuilder.NonNormativeExample("/etc/tls/tls.crt", "a PEM-encoded certificate file")
+	b.certBuilder.Documentation().
+		Paragraph(
+			"The file may contain intermediate certificates,",
+			"which are sent to clients after the server's own certificate.",
+		).
+		Format().
+		Done()
+
+	b.keyBuilder.Name(prefix + "_KEY_FILE")
+	b.keyBuilder.Description("TLS private key file")
+	b.keyBuilder.BuiltInConstraint(
+		fmt.Sprintf(
+			"**MUST** refer to a file containing a PEM-encoded private key that matches the certificate in `%s`",
+			b.certBuilder.Peek().Name(),
+		),
+		func(ctx variable.ConstraintContext, v FileName) variable.ConstraintError {
+			if ctx != variable.ConstraintContextFinal {
+				return nil
+			}
+			return b.checkKeyPair(v)
+		},
+	)
+	b.keyBuilder.NonNormativeExample("/etc/tls/tls.key", "a PEM-encoded private key file")
+
+	b.caBuilder.Name(prefix + "_CA_FILE")
+	b.caBuilder.Description("TLS certificate authority file used to verify client certificates")
+	b.caBuilder.BuiltInConstraint(
+		"**MUST** refer to a file containing one or more PEM-encoded certificates",
+		func(ctx variable.ConstraintContext, v FileName) variable.ConstraintError {
+			if ctx != variable.ConstraintContextFinal {
+				return nil
+			}
+
+			_, err := readCertificates(v)
+			return err
+		},
+	)
+	b.caBuilder.NonNormativeExample("/etc/tls/ca.crt", "a PEM-encoded certificate authority file")
+
+	b.minVersionBuilder.Name(prefix + "_MIN_VERSION")
+	b.minVersionBuilder.Description("minimum TLS version to accept")
+	b.minVersionBuilder.Default(tls.VersionTLS12)
+
+	b.clientAuthBuilder.Name(prefix + "_CLIENT_AUTH")
+	b.clientAuthBuilder.Description("TLS client authentication policy")
+	b.clientAuthBuilder.Default(tls.NoClientCert)
+	b.clientAuthBuilder.BuiltInConstraint(
+		fmt.Sprintf(
+			"**MUST NOT** verify client certificates unless `%s` is defined",
+			b.caBuilder.Peek().Name(),
+		),
+		func(ctx variable.ConstraintContext, v tls.ClientAuthType) variable.ConstraintError {
+			if ctx != variable.ConstraintContextFinal {
+				return nil
+			}
+			return b.checkClientAuth(v)
+		},
+	)
+
+	variable.EstablishRelationships(
+		variable.RefersTo{
+			Subject:  b.certBuilder.Peek(),
+			RefersTo: b.keyBuilder.Peek(),
+		},
+		variable.RefersTo{
+			Subject:  b.keyBuilder.Peek(),
+			RefersTo: b.certBuilder.Peek(),
+		},
+		variable.RefersTo{
+			Subject:  b.clientAuthBuilder.Peek(),
+			RefersTo: b.caBuilder.Peek(),
+		},
+		variable.RefersTo{
+			Subject:  b.caBuilder.Peek(),
+			RefersTo: b.clientAuthBuilder.Peek(),
+		},
+	)
+
+	return b
+}
+
+// TLSBuilder is the specification for a server-side TLS configuration.
+type TLSBuilder struct {
+	prefix string
+
+	certSchema       variable.TypedString[FileName]
+	keySchema        variable.TypedString[FileName]
+	caSchema         variable.TypedString[FileName]
+	minVersionSchema variable.TypedSet[uint16]
+	clientAuthSchema variable.TypedSet[tls.ClientAuthType]
+
+	certBuilder       variable.TypedSpecBuilder[FileName]
+	keyBuilder        variable.TypedSpecBuilder[FileName]
+	caBuilder         variable.TypedSpecBuilder[FileName]
+	minVersionBuilder variable.TypedSpecBuilder[uint16]
+	clientAuthBuilder variable.TypedSpecBuilder[tls.ClientAuthType]
+
+	cert       *variable.OfType[FileName]
+	key        *variable.OfType[FileName]
+	ca         *variable.OfType[FileName]
+	minVersion *variable.OfType[uint16]
+	clientAuth *variable.OfType[tls.ClientAuthType]
+}
+
+var _ isBuilderOfMinimal[
+	*tls.Config,
+	*TLSBuilder,
+]
+
+// WithMinVersion sets the default minimum TLS version, such as
+// [tls.VersionTLS13].
+//
+// It is used when the "<prefix>_MIN_VERSION" environment variable is undefined.
+// If it is not called the default is [tls.VersionTLS12].
+func (b *TLSBuilder) WithMinVersion(v uint16) *TLSBuilder {
+	if _, ok := tlsVersionNames[v]; !ok {
+		panic(fmt.Sprintf(
+			"specification for %s TLS configuration is invalid: unsupported TLS version (%#04x)",
+			b.prefix,
+			v,
+		))
+	}
+
+	b.minVersionBuilder.Default(v)
+	return b
+}
+
+// WithClientAuth sets the default client authentication policy, such as
+// [tls.RequireAndVerifyClientCert].
+//
+// It is used when the "<prefix>_CLIENT_AUTH" environment variable is undefined.
+// If it is not called the default is [tls.NoClientCert].
+func (b *TLSBuilder) WithClientAuth(v tls.ClientAuthType) *TLSBuilder {
+	if _, ok := tlsClientAuthNames[v]; !ok {
+		panic(fmt.Sprintf(
+			"specification for %s TLS configuration is invalid: unsupported client authentication policy (%d)",
+			b.prefix,
+			v,
+		))
+	}
+
+	b.clientAuthBuilder.Default(v)
+	return b
+}
+
+// Required completes the build process and registers required variables with
+// Ferrite's validation system.
+//
+// The certificate authority file is always optional.
+func (b *TLSBuilder) Required(options ...RequiredOption) Required[*tls.Config] {
+	b.certBuilder.MarkRequired()
+	b.keyBuilder.MarkRequired()
+	b.minVersionBuilder.MarkRequired()
+	b.clientAuthBuilder.MarkRequired()
+
+	var cfg variableSetConfig
+	for _, opt := range options {
+		opt.applyRequiredOptionToConfig(&cfg)
+		for _, s := range b.specBuilders() {
+			opt.applyRequiredOptionToSpec(s)
+		}
+	}
+
+	b.register(cfg)
+
+	return requiredFunc[*tls.Config]{
+		b.variables(),
+		func() (*tls.Config, error) {
+			c, _, err := b.resolve()
+			return c, err
+		},
+		literalsOfTLSConfig,
+	}
+}
+
+// Optional completes the build process and registers optional variables with
+// Ferrite's validation system.
+func (b *TLSBuilder) Optional(options ...OptionalOption) Optional[*tls.Config] {
+	var cfg variableSetConfig
+	for _, opt := range options {
+		opt.applyOptionalOptionToConfig(&cfg)
+		for _, s := range b.specBuilders() {
+			opt.applyOptionalOptionToSpec(s)
+		}
+	}
+
+	b.register(cfg)
+
+	return optionalFunc[*tls.Config]{
+		b.variables(),
+		b.resolve,
+		literalsOfTLSConfig,
+	}
+}
+
+// Deprecated completes the build process and registers deprecated variables
+// with Ferrite's validation system.
+func (b *TLSBuilder) Deprecated(options ...DeprecatedOption) Deprecated[*tls.Config] {
+	for _, s := range b.specBuilders() {
+		s.MarkDeprecated()
+	}
+
+	var cfg variableSetConfig
+	for _, opt := range options {
+		opt.applyDeprecatedOptionToConfig(&cfg)
+		for _, s := range b.specBuilders() {
+			opt.applyDeprecatedOptionToSpec(s)
+		}
+	}
+
+	b.register(cfg)
+
+	return deprecatedFunc[*tls.Config]{
+		b.variables(),
+		b.resolve,
+		literalsOfTLSConfig,
+	}
+}
+
+// specBuilders returns the builders of each of the variables in the set.
+func (b *TLSBuilder) specBuilders() []variable.SpecBuilder {
+	return []variable.SpecBuilder{
+		&b.certBuilder,
+		&b.keyBuilder,
+		&b.caBuilder,
+		&b.minVersionBuilder,
+		&b.clientAuthBuilder,
+	}
+}
+
+// register registers each of the variables in the set.
+func (b *TLSBuilder) register(cfg variableSetConfig) {
+	b.cert = variable.Register(cfg.Registries, b.certBuilder.Done(b.certSchema))
+	b.key = variable.Register(cfg.Registries, b.keyBuilder.Done(b.keySchema))
+	b.ca = variable.Register(cfg.Registries, b.caBuilder.Done(b.caSchema))
+	b.minVersion = variable.Register(cfg.Registries, b.minVersionBuilder.Done(b.minVersionSchema))
+	b.clientAuth = variable.Register(cfg.Registries, b.clientAuthBuilder.Done(b.clientAuthSchema))
+}
+
+// variables returns the registered variables in the set.
+func (b *TLSBuilder) variables() []variable.Any {
+	return []variable.Any{
+		b.cert,
+		b.key,
+		b.ca,
+		b.minVersion,
+		b.clientAuth,
+	}
+}
+
+// resolve builds the TLS configuration from the registered variables.
+func (b *TLSBuilder) resolve() (*tls.Config, bool, error) {
+	for _, v := range b.variables() {
+		if err := v.Error(); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if err := checkDefinedTogether(b.cert, b.key); err != nil {
+		return nil, false, err
+	}
+
+	if b.cert.Availability() != variable.AvailabilityOK {
+		return nil, false, nil
+	}
+
+	pair, err := tls.LoadX509KeyPair(
+		string(b.cert.NativeValue()),
+		string(b.key.NativeValue()),
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	c := &tls.Config{
+		Certificates: []tls.Certificate{pair},
+		MinVersion:   b.minVersion.NativeValue(),
+		ClientAuth:   b.clientAuth.NativeValue(),
+	}
+
+	if b.ca.Availability() == variable.AvailabilityOK {
+		certs, err := readCertificates(b.ca.NativeValue())
+		if err != nil {
+			return nil, false, err
+		}
+
+		c.ClientCAs = x509.NewCertPool()
+		for _, cert := range certs {
+			c.ClientCAs.AddCert(cert)
+		}
+	}
+
+	return c, true, nil
+}
+
+// checkKeyPair returns an error if the private key in the file named n does
+// not match the certificate in the "<prefix>_CERT_FILE" variable.
+func (b *TLSBuilder) checkKeyPair(n FileName) error {
+	keyPEM, err := n.ReadBytes()
+	if err != nil {
+		return err
+	}
+
+	switch b.cert.Availability() {
+	case variable.AvailabilityNone:
+		return fmt.Errorf(
+			"%s is undefined, define both or neither",
+			b.cert.Spec().Name(),
+		)
+	case variable.AvailabilityOK:
+	default:
+		// The certificate variable reports its own error.
+		return nil
+	}
+
+	certPEM, err := b.cert.NativeValue().ReadBytes()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tls.X509KeyPair(certPEM, keyPEM); err != nil {
+		if strings.Contains(err.Error(), "does not match") {
+			return fmt.Errorf(
+				"the private key does not match the certificate in %s",
+				b.cert.Spec().Name(),
+			)
+		}
+		return errors.New(strings.TrimPrefix(err.Error(), "tls: "))
+	}
+
+	return nil
+}
+
+// checkClientAuth returns an error if v requires client certificates to be
+// verified but the "<prefix>_CA_FILE" variable is undefined.
+func (b *TLSBuilder) checkClientAuth(v tls.ClientAuthType) error {
+	if v != tls.VerifyClientCertIfGiven && v != tls.RequireAndVerifyClientCert {
+		return nil
+	}
+
+	if b.ca.Availability() == variable.AvailabilityNone {
+		return fmt.Errorf(
+			"verifying client certificates requires %s to be defined",
+			b.ca.Spec().Name(),
+		)
+	}
+
+	return nil
+}
+
+// literalsOfTLSConfig returns an error, as a TLS configuration can not be
+// represented as environment variable values.
+func literalsOfTLSConfig(*tls.Config) ([]variable.Literal, error) {
+	return nil, errors.New("a TLS configuration can not be represented as environment variable values")
+}
+
+// readCertificates returns the PEM-encoded certificates in the file named n.
+func readCertificates(n FileName) ([]*x509.Certificate, error) {
+	data, err := n.ReadBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []*x509.Certificate
+
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("certificate %d is invalid: %w", len(certs)+1, err)
+		}
+
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("expected at least one PEM-encoded certificate")
+	}
+
+	return certs, nil
+}
+
+// tlsVersionNames is a map of supported TLS versions to their literal
+// representations.
+var tlsVersionNames = map[uint16]string{
+	tls.VersionTLS10: "1.0",
+	tls.VersionTLS11: "1.1",
+	tls.VersionTLS12: "1.2",
+	tls.VersionTLS13: "1.3",
+}
+
+// tlsClientAuthNames is a map of client authentication policies to their
+// literal representations.
+var tlsClientAuthNames = map[tls.ClientAuthType]string{
+	tls.NoClientCert:               "none",
+	tls.RequestClientCert:          "request",
+	tls.RequireAnyClientCert:       "require",
+	tls.VerifyClientCertIfGiven:    "verify-if-given",
+	tls.RequireAndVerifyClientCert: "require-and-verify",
+}
@@ -0,0 +1,371 @@
+package ferrite_test
+
+import (
+	"crypto/tls"
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type TLSBuilder", func() {
+	var builder *TLSBuilder
+
+	BeforeEach(func() {
+		builder = TLS("FERRITE_TLS")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the prefix is empty", func() {
+		Expect(func() {
+			TLS("").Optional()
+		}).To(PanicWith("TLS variable prefix must not be empty"))
+	})
+
+	It("panics if the default minimum version is unsupported", func() {
+		Expect(func() {
+			builder.WithMinVersion(0x0300)
+		}).To(PanicWith("specification for FERRITE_TLS TLS configuration is invalid: unsupported TLS version (0x0300)"))
+	})
+
+	It("panics if the default client authentication policy is unsupported", func() {
+		Expect(func() {
+			builder.WithClientAuth(100)
+		}).To(PanicWith("specification for FERRITE_TLS TLS configuration is invalid: unsupported client authentication policy (100)"))
+	})
+
+	When("the variables are required", func() {
+		When("the certificate and key are valid", func() {
+			BeforeEach(func() {
+				os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/server.crt")
+				os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/server.key")
+			})
+
+			Describe("func Value()", func() {
+				It("returns a configuration with the default settings", func() {
+					c := builder.
+						Required().
+						Value()
+
+					Expect(c.Certificates).To(HaveLen(1))
+					Expect(c.MinVersion).To(Equal(uint16(tls.VersionTLS12)))
+					Expect(c.ClientAuth).To(Equal(tls.NoClientCert))
+					Expect(c.ClientCAs).To(BeNil())
+				})
+
+				It("returns a configuration with the builder's defaults", func() {
+					c := builder.
+						WithMinVersion(tls.VersionTLS13).
+						WithClientAuth(tls.RequireAnyClientCert).
+						Required().
+						Value()
+
+					Expect(c.MinVersion).To(Equal(uint16(tls.VersionTLS13)))
+					Expect(c.ClientAuth).To(Equal(tls.RequireAnyClientCert))
+				})
+
+				DescribeTable(
+					"it returns a configuration with the minimum version from the environment",
+					func(value string, expect uint16) {
+						os.Setenv("FERRITE_TLS_MIN_VERSION", value)
+
+						c := builder.
+							Required().
+							Value()
+
+						Expect(c.MinVersion).To(Equal(expect))
+					},
+					Entry("1.0", "1.0", uint16(tls.VersionTLS10)),
+					Entry("1.1", "1.1", uint16(tls.VersionTLS11)),
+					Entry("1.2", "1.2", uint16(tls.VersionTLS12)),
+					Entry("1.3", "1.3", uint16(tls.VersionTLS13)),
+				)
+
+				DescribeTable(
+					"it returns a configuration with the client authentication policy from the environment",
+					func(value string, expect tls.ClientAuthType) {
+						os.Setenv("FERRITE_TLS_CA_FILE", "testdata/tls/ca.crt")
+						os.Setenv("FERRITE_TLS_CLIENT_AUTH", value)
+
+						c := builder.
+							Required().
+							Value()
+
+						Expect(c.ClientAuth).To(Equal(expect))
+					},
+					Entry("none", "none", tls.NoClientCert),
+					Entry("request", "request", tls.RequestClientCert),
+					Entry("require", "require", tls.RequireAnyClientCert),
+					Entry("verify-if-given", "verify-if-given", tls.VerifyClientCertIfGiven),
+					Entry("require-and-verify", "require-and-verify", tls.RequireAndVerifyClientCert),
+					Entry("case-insensitive", "Require-And-Verify", tls.RequireAndVerifyClientCert),
+				)
+
+				It("returns a configuration with the client certificate authorities", func() {
+					os.Setenv("FERRITE_TLS_CA_FILE", "testdata/tls/ca.crt")
+
+					c := builder.
+						Required().
+						Value()
+
+					Expect(c.ClientCAs).NotTo(BeNil())
+				})
+			})
+		})
+
+		When("the key does not match the certificate", func() {
+			BeforeEach(func() {
+				os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/server.crt")
+				os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/other.key")
+			})
+
+			Describe("func Value()", func() {
+				It("panics", func() {
+					Expect(func() {
+						builder.
+							Required().
+							Value()
+					}).To(PanicWith("value of FERRITE_TLS_KEY_FILE (testdata/tls/other.key) is invalid: the private key does not match the certificate in FERRITE_TLS_CERT_FILE"))
+				})
+			})
+		})
+
+		When("the certificate file does not contain a certificate", func() {
+			BeforeEach(func() {
+				os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/server.key")
+				os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/server.key")
+			})
+
+			Describe("func Value()", func() {
+				It("panics", func() {
+					Expect(func() {
+						builder.
+							Required().
+							Value()
+					}).To(PanicWith("value of FERRITE_TLS_CERT_FILE (testdata/tls/server.key) is invalid: expected at least one PEM-encoded certificate"))
+				})
+			})
+		})
+
+		When("the client authentication policy verifies certificates without a certificate authority", func() {
+			BeforeEach(func() {
+				os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/server.crt")
+				os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/server.key")
+				os.Setenv("FERRITE_TLS_CLIENT_AUTH", "require-and-verify")
+			})
+
+			Describe("func Value()", func() {
+				It("panics", func() {
+					Expect(func() {
+						builder.
+							Required().
+							Value()
+					}).To(PanicWith("value of FERRITE_TLS_CLIENT_AUTH (require-and-verify) is invalid: verifying client certificates requires FERRITE_TLS_CA_FILE to be defined"))
+				})
+			})
+		})
+
+		When("the certificate is undefined", func() {
+			BeforeEach(func() {
+				os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/server.key")
+			})
+
+			Describe("func Value()", func() {
+				It("panics", func() {
+					Expect(func() {
+						builder.
+							Required().
+							Value()
+					}).To(PanicWith("FERRITE_TLS_CERT_FILE is undefined and does not have a default value"))
+				})
+			})
+		})
+	})
+
+	When("the variables are optional", func() {
+		When("the certificate and key are valid", func() {
+			BeforeEach(func() {
+				os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/server.crt")
+				os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/server.key")
+			})
+
+			Describe("func Value()", func() {
+				It("returns the configuration", func() {
+					c, ok := builder.
+						Optional().
+						Value()
+
+					Expect(ok).To(BeTrue())
+					Expect(c.Certificates).To(HaveLen(1))
+				})
+			})
+		})
+
+		When("the certificate and key are undefined", func() {
+			Describe("func Value()", func() {
+				It("returns with ok == false", func() {
+					c, ok := builder.
+						Optional().
+						Value()
+
+					Expect(ok).To(BeFalse())
+					Expect(c).To(BeNil())
+				})
+			})
+		})
+
+		When("only the certificate is defined", func() {
+			BeforeEach(func() {
+				os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/server.crt")
+			})
+
+			Describe("func Value()", func() {
+				It("panics", func() {
+					Expect(func() {
+						builder.
+							Optional().
+							Value()
+					}).To(PanicWith("FERRITE_TLS_CERT_FILE is defined but FERRITE_TLS_KEY_FILE is not, define both or neither"))
+				})
+			})
+		})
+
+		When("only the key is defined", func() {
+			BeforeEach(func() {
+				os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/server.key")
+			})
+
+			Describe("func Value()", func() {
+				It("panics", func() {
+					Expect(func() {
+						builder.
+							Optional().
+							Value()
+					}).To(PanicWith("value of FERRITE_TLS_KEY_FILE (testdata/tls/server.key) is invalid: FERRITE_TLS_CERT_FILE is undefined, define both or neither"))
+				})
+			})
+		})
+	})
+})
+
+func ExampleTLS_required() {
+	defer example()()
+
+	v := ferrite.
+		TLS("FERRITE_TLS").
+		Required()
+
+	os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/server.crt")
+	os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/server.key")
+	ferrite.Init()
+
+	c := v.Value()
+	fmt.Println("certificates:", len(c.Certificates))
+	fmt.Println("minimum version:", tls.VersionName(c.MinVersion))
+	fmt.Println("client auth:", c.ClientAuth)
+
+	// Output:
+	// certificates: 1
+	// minimum version: TLS 1.2
+	// client auth: NoClientCert
+}
+
+func ExampleTLS_default() {
+	defer example()()
+
+	v := ferrite.
+		TLS("FERRITE_TLS").
+		WithMinVersion(tls.VersionTLS13).
+		WithClientAuth(tls.VerifyClientCertIfGiven).
+		Required()
+
+	os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/server.crt")
+	os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/server.key")
+	os.Setenv("FERRITE_TLS_CA_FILE", "testdata/tls/ca.crt")
+	ferrite.Init()
+
+	c := v.Value()
+	fmt.Println("minimum version:", tls.VersionName(c.MinVersion))
+	fmt.Println("client auth:", c.ClientAuth)
+
+	// Output:
+	// minimum version: TLS 1.3
+	// client auth: VerifyClientCertIfGiven
+}
+
+func ExampleTLS_optional() {
+	defer example()()
+
+	v := ferrite.
+		TLS("FERRITE_TLS").
+		Optional()
+
+	ferrite.Init()
+
+	if _, ok := v.Value(); ok {
+		fmt.Println("TLS is enabled")
+	} else {
+		fmt.Println("TLS is disabled")
+	}
+
+	// Output:
+	// TLS is disabled
+}
+
+func ExampleTLS_deprecated() {
+	defer example()()
+
+	v := ferrite.
+		TLS("FERRITE_TLS").
+		Deprecated()
+
+	os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/server.crt")
+	os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/server.key")
+	ferrite.Init()
+
+	if _, ok := v.DeprecatedValue(); ok {
+		fmt.Println("TLS is enabled")
+	} else {
+		fmt.Println("TLS is disabled")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_TLS_CA_FILE      TLS certificate authority file used to verify client certificates  [ <string> ]                                                                • undefined
+	//  ❯ FERRITE_TLS_CERT_FILE    TLS certificate file                                               [ <string> ]                                                                ⚠ deprecated variable set to testdata/tls/server.crt
+	//    FERRITE_TLS_CLIENT_AUTH  TLS client authentication policy                                   [ none | request | require | verify-if-given | require-and-verify ] = none  ✓ using default value
+	//  ❯ FERRITE_TLS_KEY_FILE     TLS private key file                                               [ <string> ]                                                                ⚠ deprecated variable set to testdata/tls/server.key
+	//    FERRITE_TLS_MIN_VERSION  minimum TLS version to accept                                      [ 1.0 | 1.1 | 1.2 | 1.3 ] = 1.2                                             ✓ using default value
+	//
+	// TLS is enabled
+}
+
+func ExampleTLS_mismatchedKey() {
+	defer example()()
+
+	ferrite.
+		TLS("FERRITE_TLS").
+		Required()
+
+	os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/server.crt")
+	os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/other.key")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_TLS_CA_FILE      TLS certificate authority file used to verify client certificates  [ <string> ]                                                                • undefined
+	//    FERRITE_TLS_CERT_FILE    TLS certificate file                                                 <string>                                                                  ✓ set to testdata/tls/server.crt
+	//    FERRITE_TLS_CLIENT_AUTH  TLS client authentication policy                                   [ none | request | require | verify-if-given | require-and-verify ] = none  ✓ using default value
+	//  ❯ FERRITE_TLS_KEY_FILE     TLS private key file                                                 <string>                                                                  ✗ set to testdata/tls/other.key, the private key does not match the certificate in FERRITE_TLS_CERT_FILE
+	//    FERRITE_TLS_MIN_VERSION  minimum TLS version to accept                                      [ 1.0 | 1.1 | 1.2 | 1.3 ] = 1.2                                             ✓ using default value
+	//
+	// <process exited with error code 1>
+}
@@ -0,0 +1,185 @@
+package ferrite
+
+import (
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// TriStateValue is a boolean value that may also be set to "auto", indicating
+// that the application should determine the value itself.
+type TriStateValue int
+
+const (
+	// TriStateAuto indicates that the application should determine the value
+	// itself, typically by auto-detection.
+	TriStateAuto TriStateValue = iota
+
+	// TriStateTrue is the explicit boolean value true.
+	TriStateTrue
+
+	// TriStateFalse is the explicit boolean value false.
+	TriStateFalse
+)
+
+// IsAuto returns true if v is [TriStateAuto].
+func (v TriStateValue) IsAuto() bool {
+	return v == TriStateAuto
+}
+
+// Bool returns the boolean value of v.
+//
+// ok is false if v is [TriStateAuto].
+func (v TriStateValue) Bool() (value, ok bool) {
+	switch v {
+	case TriStateTrue:
+		return true, true
+	case TriStateFalse:
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// Resolve returns the boolean value of v, or the result of calling auto if v is
+// [TriStateAuto].
+func (v TriStateValue) Resolve(auto func() bool) bool {
+	if b, ok := v.Bool(); ok {
+		return b
+	}
+	return auto()
+}
+
+// String returns the literal representation of v.
+func (v TriStateValue) String() string {
+	switch v {
+	case TriStateTrue:
+		return "true"
+	case TriStateFalse:
+		return "false"
+	default:
+		return "auto"
+	}
+}
+
+// truthyValue returns the value that is considered "truthy" by the
+// [RelevantIf] option.
+//
+// Only an explicit true value is truthy, such that a variable set that is
+// "relevant if" a tri-state variable is irrelevant when the tri-state variable
+// is false or "auto".
+func (TriStateValue) truthyValue() any {
+	return TriStateTrue
+}
+
+// TriState configures an environment variable as a boolean that may also be
+// set to "auto".
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func TriState(name, desc string) *TriStateBuilder {
+	b := &TriStateBuilder{
+		schema: variable.TypedSet[TriStateValue]{
+			Members: []variable.SetMember[TriStateValue]{
+				{Value: TriStateTrue},
+				{Value: TriStateFalse},
+				{
+					Value:       TriStateAuto,
+					Description: "determined automatically by the application",
+				},
+			},
+			ToLiteral: func(v TriStateValue) variable.Literal {
+				return variable.Literal{String: v.String()}
+			},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// TriStateBuilder builds a specification for a tri-state boolean value.
+type TriStateBuilder struct {
+	schema   variable.TypedSet[TriStateValue]
+	builder  variable.TypedSpecBuilder[TriStateValue]
+	flexible bool
+}
+
+var _ isBuilderOfMinimal[
+	TriStateValue,
+	*TriStateBuilder,
+]
+
+// WithFlexibleLiterals allows the variable to be set to any of a family of
+// commonly used boolean literals, in addition to "true", "false" and "auto".
+//
+// The values "yes", "on" and "1" are accepted as true, and "no", "off" and "0"
+// are accepted as false. Literals are matched without regard to case.
+func (b *TriStateBuilder) WithFlexibleLiterals() *TriStateBuilder {
+	b.flexible = true
+	return b
+}
+
+// WithDefault sets the default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *TriStateBuilder) WithDefault(v TriStateValue) *TriStateBuilder {
+	b.builder.Default(v)
+	return b
+}
+
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *TriStateBuilder) WithDefaultFunc(
+	fn func() (v TriStateValue, ok bool),
+	from ...AnyVariableSet,
+) *TriStateBuilder {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *TriStateBuilder) WithDefaultIn(p *Profile, v TriStateValue) *TriStateBuilder {
+	b.builder.ProfileDefault(p.profile, v)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *TriStateBuilder) Required(options ...RequiredOption) Required[TriStateValue] {
+	b.addFlexibleLiterals()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *TriStateBuilder) Optional(options ...OptionalOption) Optional[TriStateValue] {
+	b.addFlexibleLiterals()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *TriStateBuilder) Deprecated(options ...DeprecatedOption) Deprecated[TriStateValue] {
+	b.addFlexibleLiterals()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+// addFlexibleLiterals adds the flexible boolean literals to the schema if
+// [TriStateBuilder.WithFlexibleLiterals] was called.
+func (b *TriStateBuilder) addFlexibleLiterals() {
+	if b.flexible {
+		addFlexibleBoolLiterals(&b.schema, TriStateTrue, TriStateFalse)
+	}
+}
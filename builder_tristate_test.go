@@ -0,0 +1,243 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type TriStateBuilder", func() {
+	var builder *TriStateBuilder
+
+	BeforeEach(func() {
+		builder = TriState("FERRITE_TRI_STATE", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			TriState("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			TriState("FERRITE_TRI_STATE", "").Optional()
+		}).To(PanicWith("specification for FERRITE_TRI_STATE is invalid: variable description must not be empty"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is one of the accepted literals", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value associated with the literal",
+					func(value string, expect TriStateValue) {
+						os.Setenv("FERRITE_TRI_STATE", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					},
+					Entry("true", "true", TriStateTrue),
+					Entry("false", "false", TriStateFalse),
+					Entry("auto", "auto", TriStateAuto),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				It("panics", func() {
+					os.Setenv("FERRITE_TRI_STATE", "<invalid>")
+
+					Expect(func() {
+						builder.
+							Required().
+							Value()
+					}).To(PanicWith(
+						`value of FERRITE_TRI_STATE ('<invalid>') is invalid: expected true, false or auto`,
+					))
+				})
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(TriStateAuto).
+							Required().
+							Value()
+
+						Expect(v).To(Equal(TriStateAuto))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_TRI_STATE is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("flexible literals are enabled", func() {
+		DescribeTable(
+			"it accepts the flexible literals",
+			func(value string, expect TriStateValue) {
+				os.Setenv("FERRITE_TRI_STATE", value)
+
+				v := builder.
+					WithFlexibleLiterals().
+					Required().
+					Value()
+
+				Expect(v).To(Equal(expect))
+			},
+			Entry("yes", "yes", TriStateTrue),
+			Entry("on", "ON", TriStateTrue),
+			Entry("1", "1", TriStateTrue),
+			Entry("no", "No", TriStateFalse),
+			Entry("off", "off", TriStateFalse),
+			Entry("0", "0", TriStateFalse),
+			Entry("auto", "AUTO", TriStateAuto),
+		)
+	})
+})
+
+var _ = Describe("type TriStateValue", func() {
+	DescribeTable(
+		"func Bool()",
+		func(v TriStateValue, expectValue, expectOK bool) {
+			value, ok := v.Bool()
+			Expect(value).To(Equal(expectValue))
+			Expect(ok).To(Equal(expectOK))
+		},
+		Entry("true", TriStateTrue, true, true),
+		Entry("false", TriStateFalse, false, true),
+		Entry("auto", TriStateAuto, false, false),
+	)
+
+	Describe("func Resolve()", func() {
+		It("calls the auto function only if the value is auto", func() {
+			auto := func() bool { return true }
+			Expect(TriStateAuto.Resolve(auto)).To(BeTrue())
+			Expect(TriStateFalse.Resolve(auto)).To(BeFalse())
+		})
+	})
+})
+
+func ExampleTriState_required() {
+	defer example()()
+
+	v := ferrite.
+		TriState("FERRITE_TRI_STATE", "example tri-state variable").
+		Required()
+
+	os.Setenv("FERRITE_TRI_STATE", "auto")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+	fmt.Println("resolved value is", v.Value().Resolve(func() bool {
+		return true // auto-detect the value
+	}))
+
+	// Output:
+	// value is auto
+	// resolved value is true
+}
+
+func ExampleTriState_default() {
+	defer example()()
+
+	v := ferrite.
+		TriState("FERRITE_TRI_STATE", "example tri-state variable").
+		WithDefault(ferrite.TriStateAuto).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is auto
+}
+
+func ExampleTriState_optional() {
+	defer example()()
+
+	v := ferrite.
+		TriState("FERRITE_TRI_STATE", "example tri-state variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleTriState_deprecated() {
+	defer example()()
+
+	v := ferrite.
+		TriState("FERRITE_TRI_STATE", "example tri-state variable").
+		WithFlexibleLiterals().
+		Deprecated()
+
+	os.Setenv("FERRITE_TRI_STATE", "yes")
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_TRI_STATE  example tri-state variable  [ true | false | auto ]  ⚠ deprecated variable set to yes, equivalent to true
+	//
+	// value is true
+}
@@ -23,31 +23,15 @@ func Unsigned[T constraints.Unsigned](name, desc string) *UnsignedBuilder[T] {
 
 	b.builder.Name(name)
 	b.builder.Description(desc)
-	b.builder.Documentation().
-		Summary("Unsigned integer syntax").
-		Paragraph(
-			"Unsigned integers can only be specified using decimal (base-10) notation.",
-			"A leading sign (`+` or `-`) is not supported and **MUST NOT** be specified.",
-		).
-		Format().
-		Paragraph(
-			"Internally, the `%s` variable is represented using an unsigned %d-bit integer type (`%s`);",
-			"any value that overflows this data-type is invalid.",
-		).
-		Format(
-			name,
-			reflectx.BitSize[T](),
-			reflectx.KindOf[T](),
-		).
-		Done()
 
 	return b
 }
 
 // UnsignedBuilder builds a specification for an unsigned integer value.
 type UnsignedBuilder[T constraints.Unsigned] struct {
-	schema  variable.TypedNumeric[T]
-	builder variable.TypedSpecBuilder[T]
+	schema    variable.TypedNumeric[T]
+	builder   variable.TypedSpecBuilder[T]
+	marshaler unsignedMarshaler[T]
 }
 
 var _ isBuilderOf[
@@ -56,6 +40,8 @@ var _ isBuilderOf[
 	*UnsignedBuilder[uint],
 ]
 
+var _ isFinalizedBuilder[*UnsignedBuilder[uint]]
+
 // WithDefault sets the default value of the variable.
 //
 // It is used when the environment variable is undefined or empty.
@@ -64,6 +50,33 @@ func (b *UnsignedBuilder[T]) WithDefault(v T) *UnsignedBuilder[T] {
 	return b
 }
 
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *UnsignedBuilder[T]) WithDefaultFunc(
+	fn func() (v T, ok bool),
+	from ...AnyVariableSet,
+) *UnsignedBuilder[T] {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *UnsignedBuilder[T]) WithDefaultIn(p *Profile, v T) *UnsignedBuilder[T] {
+	b.builder.ProfileDefault(p.profile, v)
+	return b
+}
+
 // WithExample adds an example value to the variable's documentation.
 func (b *UnsignedBuilder[T]) WithExample(v T, desc string) *UnsignedBuilder[T] {
 	b.builder.NormativeExample(v, desc)
@@ -82,25 +95,89 @@ func (b *UnsignedBuilder[T]) WithMaximum(v T) *UnsignedBuilder[T] {
 	return b
 }
 
+// WithMultipleOf requires the value to be an exact multiple of n.
+//
+// n must be positive.
+func (b *UnsignedBuilder[T]) WithMultipleOf(n T) *UnsignedBuilder[T] {
+	b.schema.NativeMultipleOf = maybe.Some(n)
+	return b
+}
+
+// WithPowerOfTwo requires the value to be a power of two, such as 1, 2, 4 or 8.
+func (b *UnsignedBuilder[T]) WithPowerOfTwo() *UnsignedBuilder[T] {
+	b.schema.PowerOfTwo = true
+	return b
+}
+
+// WithBasePrefixes allows the value to be specified in hexadecimal, octal or
+// binary notation, using the `0x`, `0o` and `0b` prefixes, respectively.
+//
+// It also allows underscores to be used to separate digits, such as
+// `1_000_000`. The syntax is the same as that used for integer literals in Go
+// source code, except that a leading zero alone is not accepted as an octal
+// prefix.
+func (b *UnsignedBuilder[T]) WithBasePrefixes() *UnsignedBuilder[T] {
+	b.marshaler.basePrefixes = true
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *UnsignedBuilder[T]) Required(options ...RequiredOption) Required[T] {
+	b.finalize()
 	return required(b.schema, &b.builder, options...)
 }
 
 // Optional completes the build process and registers an optional variable with
 // Ferrite's validation system.
 func (b *UnsignedBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
+	b.finalize()
 	return optional(b.schema, &b.builder, options...)
 }
 
 // Deprecated completes the build process and registers a deprecated variable
 // with Ferrite's validation system.
 func (b *UnsignedBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
+	b.finalize()
 	return deprecated(b.schema, &b.builder, options...)
 }
 
-type unsignedMarshaler[T constraints.Unsigned] struct{}
+// finalize documents the integer syntax accepted by the marshaler.
+func (b *UnsignedBuilder[T]) finalize() {
+	b.schema.Marshaler = b.marshaler
+
+	syntax := []string{
+		"Unsigned integers can only be specified using decimal (base-10) notation.",
+		"A leading sign (`+` or `-`) is not supported and **MUST NOT** be specified.",
+	}
+
+	if b.marshaler.basePrefixes {
+		syntax = []string{
+			"Unsigned integers may be specified using decimal (base-10), hexadecimal (`0x`), octal (`0o`) or binary (`0b`) notation, such as `255`, `0xff`, `0o377` or `0b11111111`.",
+			"Underscores may be used to separate digits, such as `1_000_000`.",
+			"A leading sign (`+` or `-`) is not supported and **MUST NOT** be specified.",
+		}
+	}
+
+	b.builder.Documentation().
+		Summary("Unsigned integer syntax").
+		Paragraph(syntax...).
+		Format().
+		Paragraph(
+			"Internally, the `%s` variable is represented using an unsigned %d-bit integer type (`%s`);",
+			"any value that overflows this data-type is invalid.",
+		).
+		Format(
+			b.builder.Peek().Name(),
+			reflectx.BitSize[T](),
+			reflectx.KindOf[T](),
+		).
+		Done()
+}
+
+type unsignedMarshaler[T constraints.Unsigned] struct {
+	basePrefixes bool
+}
 
 func (unsignedMarshaler[T]) Marshal(v T) (variable.Literal, error) {
 	return variable.Literal{
@@ -108,8 +185,17 @@ func (unsignedMarshaler[T]) Marshal(v T) (variable.Literal, error) {
 	}, nil
 }
 
-func (unsignedMarshaler[T]) Unmarshal(v variable.Literal) (T, error) {
-	n, err := strconv.ParseUint(v.String, 10, reflectx.BitSize[T]())
+func (m unsignedMarshaler[T]) Unmarshal(v variable.Literal) (T, error) {
+	base := 10
+
+	if m.basePrefixes {
+		if err := checkLegacyOctalSyntax(v.String); err != nil {
+			return 0, err
+		}
+		base = 0
+	}
+
+	n, err := strconv.ParseUint(v.String, base, reflectx.BitSize[T]())
 	return T(n), variable.UnwrapNumericParseError(err, formatUnsigned[T])
 }
 
@@ -240,6 +240,120 @@ var _ = Describe("type UnsignedBuilder", func() {
 			))
 		})
 	})
+
+	When("the value is not a multiple of the required number", func() {
+		It("panics", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_UNSIGNED", "10")
+
+				builder.
+					WithMultipleOf(4).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_UNSIGNED (10) is invalid: expected a multiple of 4`,
+			))
+		})
+	})
+
+	When("the multiple-of value is not positive", func() {
+		It("panics", func() {
+			Expect(func() {
+				builder.
+					WithMultipleOf(0).
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_UNSIGNED is invalid: multiple-of value must be positive`,
+			))
+		})
+	})
+
+	When("the value is not a power of two", func() {
+		DescribeTable(
+			"it panics",
+			func(value, expect string) {
+				os.Setenv("FERRITE_UNSIGNED", value)
+
+				Expect(func() {
+					builder.
+						WithPowerOfTwo().
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry("zero", "0", `value of FERRITE_UNSIGNED (0) is invalid: expected a power of two`),
+			Entry("not a power of two", "12", `value of FERRITE_UNSIGNED (12) is invalid: expected a power of two`),
+		)
+
+		It("accepts powers of two", func() {
+			os.Setenv("FERRITE_UNSIGNED", "64")
+
+			v := builder.
+				WithPowerOfTwo().
+				Required().
+				Value()
+
+			Expect(v).To(Equal(userDefinedUnsigned(64)))
+		})
+	})
+
+	When("base prefixes are enabled", func() {
+		DescribeTable(
+			"it returns the value",
+			func(value string, expect int) {
+				os.Setenv("FERRITE_UNSIGNED", value)
+
+				v := builder.
+					WithBasePrefixes().
+					Required().
+					Value()
+
+				Expect(v).To(Equal(userDefinedUnsigned(expect)))
+			},
+			Entry("decimal", "255", 255),
+			Entry("zero", "0", 0),
+			Entry("hexadecimal", "0xff", 255),
+			Entry("octal", "0o377", 255),
+			Entry("binary", "0b11111111", 255),
+			Entry("underscores", "1_000", 1000),
+		)
+
+		DescribeTable(
+			"it panics if the value is invalid",
+			func(value, expect string) {
+				os.Setenv("FERRITE_UNSIGNED", value)
+
+				Expect(func() {
+					builder.
+						WithBasePrefixes().
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"legacy octal",
+				"0377",
+				`value of FERRITE_UNSIGNED (0377) is invalid: leading zeros are not permitted, use the 0o prefix for octal notation`,
+			),
+			Entry(
+				"misplaced underscore",
+				"1__000",
+				`value of FERRITE_UNSIGNED (1__000) is invalid: unrecognized uint16 syntax`,
+			),
+		)
+
+		It("does not accept prefixes when disabled", func() {
+			os.Setenv("FERRITE_UNSIGNED", "0xff")
+
+			Expect(func() {
+				builder.
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_UNSIGNED (0xff) is invalid: unrecognized uint16 syntax`,
+			))
+		})
+	})
 })
 
 func ExampleUnsigned_required() {
@@ -311,6 +425,24 @@ func ExampleUnsigned_limits() {
 	// value is 7
 }
 
+func ExampleUnsigned_basePrefixes() {
+	defer example()()
+
+	v := ferrite.
+		Unsigned[uint]("FERRITE_UNSIGNED", "example unsigned integer variable").
+		WithBasePrefixes().
+		WithPowerOfTwo().
+		Required()
+
+	os.Setenv("FERRITE_UNSIGNED", "0x1000")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 4096
+}
+
 func ExampleUnsigned_deprecated() {
 	defer example()()
 
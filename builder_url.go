@@ -73,6 +73,33 @@ func (b *URLBuilder) WithDefault(v string) *URLBuilder {
 	return b
 }
 
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *URLBuilder) WithDefaultFunc(
+	fn func() (v *url.URL, ok bool),
+	from ...AnyVariableSet,
+) *URLBuilder {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *URLBuilder) WithDefaultIn(p *Profile, v string) *URLBuilder {
+	b.builder.ProfileDefault(p.profile, mustParseURL(v))
+	return b
+}
+
 // WithExample adds an example value to the variable's documentation.
 func (b *URLBuilder) WithExample(v string, desc string) *URLBuilder {
 	b.builder.NormativeExample(mustParseURL(v), desc)
@@ -0,0 +1,324 @@
+package ferrite
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/inflect"
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// UUIDValue is a universally unique identifier, as produced by a [UUID]
+// variable.
+type UUIDValue [16]byte
+
+// Version returns the version number of the UUID, as described by RFC 9562.
+func (v UUIDValue) Version() int {
+	return int(v[6] >> 4)
+}
+
+// String returns the canonical representation of the UUID, which uses
+// lowercase hexadecimal digits separated into groups by hyphens.
+func (v UUIDValue) String() string {
+	var buf [36]byte
+
+	hex.Encode(buf[0:8], v[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], v[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], v[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], v[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], v[10:])
+
+	return string(buf[:])
+}
+
+// hasStandardVariant returns true if v uses the variant described by RFC 9562
+// (formerly RFC 4122).
+func (v UUIDValue) hasStandardVariant() bool {
+	return v[8]&0xc0 == 0x80
+}
+
+// UUID configures an environment variable as a universally unique identifier.
+//
+// The UUID must use the variant described by RFC 9562. By default, any of the
+// versions described by RFC 9562 are accepted.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func UUID(name, desc string) *UUIDBuilder {
+	b := &UUIDBuilder{}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.Documentation().
+		Summary("UUID syntax").
+		Paragraph(
+			"UUIDs are specified as 32 hexadecimal digits, typically separated into groups by hyphens,",
+			"such as `f47ac10b-58cc-4372-a567-0e02b2c3d479`.",
+			"The digits are not case-sensitive.",
+			"The hyphens are **OPTIONAL**, and the UUID may be enclosed in braces or prefixed with `urn:uuid:`.",
+		).
+		Format().
+		Done()
+
+	return b
+}
+
+// UUIDBuilder builds a specification for a UUID variable.
+type UUIDBuilder struct {
+	schema  uuidSchema
+	builder variable.TypedSpecBuilder[UUIDValue]
+
+	constraints userConstraints[UUIDValue]
+}
+
+var _ isBuilderOf[
+	UUIDValue,
+	string,
+	*UUIDBuilder,
+]
+
+var _ isFinalizedBuilder[*UUIDBuilder]
+
+// WithDefault sets the default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *UUIDBuilder) WithDefault(v string) *UUIDBuilder {
+	b.builder.Default(mustParseUUID(v))
+	return b
+}
+
+// WithDefaultFunc sets a function that computes the default value of the
+// variable.
+//
+// fn is called each time the variable is resolved while the environment
+// variable is undefined or empty. If ok is false the variable is treated as
+// though it has no default value. from is the list of variable sets that the
+// default value is derived from, if any.
+//
+// See also [WithDefaultFrom].
+func (b *UUIDBuilder) WithDefaultFunc(
+	fn func() (v UUIDValue, ok bool),
+	from ...AnyVariableSet,
+) *UUIDBuilder {
+	b.builder.DerivedDefault(fn, specsOf(from)...)
+	return b
+}
+
+// WithDefaultIn sets the default value of the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over the default value set by WithDefault() or
+// WithDefaultFunc().
+func (b *UUIDBuilder) WithDefaultIn(p *Profile, v string) *UUIDBuilder {
+	b.builder.ProfileDefault(p.profile, mustParseUUID(v))
+	return b
+}
+
+// WithExample adds an example value to the variable's documentation.
+func (b *UUIDBuilder) WithExample(v string, desc string) *UUIDBuilder {
+	b.builder.NormativeExample(mustParseUUID(v), desc)
+	return b
+}
+
+// WithVersions restricts the variable to UUIDs of the given versions, such as
+// 4 (random) or 7 (time-ordered).
+func (b *UUIDBuilder) WithVersions(versions ...int) *UUIDBuilder {
+	b.schema.Versions = append(b.schema.Versions, versions...)
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the environment variable value after it is parsed. If fn
+// returns false the value is considered invalid.
+func (b *UUIDBuilder) WithConstraint(
+	desc string,
+	fn func(UUIDValue) bool,
+) *UUIDBuilder {
+	b.constraints.add(desc, fn)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *UUIDBuilder) Required(options ...RequiredOption) Required[UUIDValue] {
+	b.finalize()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *UUIDBuilder) Optional(options ...OptionalOption) Optional[UUIDValue] {
+	b.finalize()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *UUIDBuilder) Deprecated(options ...DeprecatedOption) Deprecated[UUIDValue] {
+	b.finalize()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+// finalize adds the constraint on the permitted UUID versions.
+func (b *UUIDBuilder) finalize() {
+	versions := b.schema.Versions
+
+	var names []string
+	for _, v := range versions {
+		names = append(names, strconv.Itoa(v))
+	}
+
+	desc := "**MUST** be a UUID"
+	if len(versions) != 0 {
+		desc = fmt.Sprintf(
+			"**MUST** be a version %s UUID",
+			inflect.OrList(names),
+		)
+	}
+
+	b.builder.BuiltInConstraint(
+		desc,
+		func(_ variable.ConstraintContext, v UUIDValue) variable.ConstraintError {
+			if len(versions) == 0 || slices.Contains(versions, v.Version()) {
+				return nil
+			}
+
+			return fmt.Errorf(
+				"expected a version %s UUID, got version %d",
+				inflect.OrList(names),
+				v.Version(),
+			)
+		},
+	)
+
+	b.constraints.apply(&b.builder)
+}
+
+// uuidSchema is the schema for a [UUID] variable.
+type uuidSchema struct {
+	Versions []int
+}
+
+func (s uuidSchema) Type() reflect.Type {
+	return reflectx.TypeOf[UUIDValue]()
+}
+
+func (s uuidSchema) Finalize() error {
+	for _, v := range s.Versions {
+		if v < 1 || v > 8 {
+			return fmt.Errorf("UUID version %d is not defined by RFC 9562", v)
+		}
+	}
+
+	return nil
+}
+
+func (s uuidSchema) AcceptVisitor(v variable.SchemaVisitor) {
+	v.VisitOther(s)
+}
+
+func (s uuidSchema) Marshal(v UUIDValue) (variable.Literal, error) {
+	if !v.hasStandardVariant() {
+		return variable.Literal{}, errors.New("expected a UUID with the RFC 9562 variant")
+	}
+
+	return variable.Literal{String: v.String()}, nil
+}
+
+func (s uuidSchema) Unmarshal(v variable.Literal) (UUIDValue, error) {
+	return parseUUID(v.String)
+}
+
+func (s uuidSchema) Examples(conservative bool) []variable.TypedExample[UUIDValue] {
+	versions := s.Versions
+	if len(versions) == 0 {
+		versions = []int{4, 7}
+	}
+
+	var examples []variable.TypedExample[UUIDValue]
+
+	for _, ver := range versions {
+		examples = append(examples, variable.TypedExample[UUIDValue]{
+			Native:      exampleUUID(ver),
+			Description: fmt.Sprintf("a version %d UUID", ver),
+		})
+
+		if conservative {
+			break
+		}
+	}
+
+	return examples
+}
+
+// parseUUID parses a UUID in any of the supported formats.
+func parseUUID(s string) (UUIDValue, error) {
+	var v UUIDValue
+
+	if len(s) > 9 && strings.EqualFold(s[:9], "urn:uuid:") {
+		s = s[9:]
+	} else if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+		s = s[1 : len(s)-1]
+	}
+
+	digits := s
+	if len(s) == 36 {
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return v, errors.New("expected a UUID, such as f47ac10b-58cc-4372-a567-0e02b2c3d479")
+		}
+		digits = s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	}
+
+	if len(digits) != 32 {
+		return v, errors.New("expected a UUID, such as f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	}
+
+	if _, err := hex.Decode(v[:], []byte(digits)); err != nil {
+		return v, errors.New("expected a UUID, such as f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	}
+
+	if !v.hasStandardVariant() {
+		return v, errors.New("expected a UUID with the RFC 9562 variant")
+	}
+
+	if ver := v.Version(); ver < 1 || ver > 8 {
+		return v, fmt.Errorf("UUID version %d is not defined by RFC 9562", ver)
+	}
+
+	return v, nil
+}
+
+// exampleUUID returns a fixed UUID with the given version.
+func exampleUUID(version int) UUIDValue {
+	v := UUIDValue{
+		0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72,
+		0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79,
+	}
+
+	if version == 7 {
+		// Use a realistic timestamp for time-ordered UUIDs.
+		copy(v[:6], []byte{0x01, 0x89, 0x0a, 0x5d, 0xac, 0x96})
+	}
+
+	v[6] = v[6]&0x0f | byte(version)<<4
+	return v
+}
+
+func mustParseUUID(v string) UUIDValue {
+	u, err := parseUUID(v)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
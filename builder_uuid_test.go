@@ -0,0 +1,249 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type UUIDBuilder", func() {
+	var builder *UUIDBuilder
+
+	BeforeEach(func() {
+		builder = UUID("FERRITE_UUID", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			UUID("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			UUID("FERRITE_UUID", "").Optional()
+		}).To(PanicWith("specification for FERRITE_UUID is invalid: variable description must not be empty"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the UUID",
+					func(value string) {
+						os.Setenv("FERRITE_UUID", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v.String()).To(Equal("f47ac10b-58cc-4372-a567-0e02b2c3d479"))
+						Expect(v.Version()).To(Equal(4))
+					},
+					Entry("canonical", "f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+					Entry("uppercase", "F47AC10B-58CC-4372-A567-0E02B2C3D479"),
+					Entry("without hyphens", "f47ac10b58cc4372a5670e02b2c3d479"),
+					Entry("braces", "{f47ac10b-58cc-4372-a567-0e02b2c3d479}"),
+					Entry("URN", "urn:uuid:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_UUID", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"wrong length",
+						"f47ac10b-58cc-4372-a567",
+						`value of FERRITE_UUID (f47ac10b-58cc-4372-a567) is invalid: expected a UUID, such as f47ac10b-58cc-4372-a567-0e02b2c3d479`,
+					),
+					Entry(
+						"misplaced hyphens",
+						"f47ac10b5-8cc-4372-a567-0e02b2c3d479",
+						`value of FERRITE_UUID (f47ac10b5-8cc-4372-a567-0e02b2c3d479) is invalid: expected a UUID, such as f47ac10b-58cc-4372-a567-0e02b2c3d479`,
+					),
+					Entry(
+						"non-hexadecimal digits",
+						"g47ac10b-58cc-4372-a567-0e02b2c3d479",
+						`value of FERRITE_UUID (g47ac10b-58cc-4372-a567-0e02b2c3d479) is invalid: expected a UUID, such as f47ac10b-58cc-4372-a567-0e02b2c3d479`,
+					),
+					Entry(
+						"nil UUID",
+						"00000000-0000-0000-0000-000000000000",
+						`value of FERRITE_UUID (00000000-0000-0000-0000-000000000000) is invalid: expected a UUID with the RFC 9562 variant`,
+					),
+					Entry(
+						"undefined version",
+						"f47ac10b-58cc-0372-a567-0e02b2c3d479",
+						`value of FERRITE_UUID (f47ac10b-58cc-0372-a567-0e02b2c3d479) is invalid: UUID version 0 is not defined by RFC 9562`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("6ba7b810-9dad-11d1-80b4-00c04fd430c8").
+							Required().
+							Value()
+
+						Expect(v.String()).To(Equal("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_UUID is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("there are allowed versions", func() {
+		It("panics if the UUID has some other version", func() {
+			os.Setenv("FERRITE_UUID", "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+			Expect(func() {
+				builder.
+					WithVersions(4, 7).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_UUID (6ba7b810-9dad-11d1-80b4-00c04fd430c8) is invalid: expected a version 4 or 7 UUID, got version 1`,
+			))
+		})
+
+		It("panics if a version is not defined", func() {
+			Expect(func() {
+				builder.
+					WithVersions(9).
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_UUID is invalid: UUID version 9 is not defined by RFC 9562`,
+			))
+		})
+	})
+})
+
+func ExampleUUID_required() {
+	defer example()()
+
+	v := ferrite.
+		UUID("FERRITE_UUID", "example UUID variable").
+		Required()
+
+	os.Setenv("FERRITE_UUID", "F47AC10B-58CC-4372-A567-0E02B2C3D479")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+	fmt.Println("version is", v.Value().Version())
+
+	// Output:
+	// value is f47ac10b-58cc-4372-a567-0e02b2c3d479
+	// version is 4
+}
+
+func ExampleUUID_default() {
+	defer example()()
+
+	v := ferrite.
+		UUID("FERRITE_UUID", "example UUID variable").
+		WithDefault("f47ac10b-58cc-4372-a567-0e02b2c3d479").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is f47ac10b-58cc-4372-a567-0e02b2c3d479
+}
+
+func ExampleUUID_optional() {
+	defer example()()
+
+	v := ferrite.
+		UUID("FERRITE_UUID", "example UUID variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleUUID_deprecated() {
+	defer example()()
+
+	v := ferrite.
+		UUID("FERRITE_UUID", "example UUID variable").
+		Deprecated()
+
+	os.Setenv("FERRITE_UUID", "{F47AC10B-58CC-4372-A567-0E02B2C3D479}")
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_UUID  example UUID variable  [ <string> ]  ⚠ deprecated variable set to '{F47AC10B-58CC-4372-A567-0E02B2C3D479}', equivalent to f47ac10b-58cc-4372-a567-0e02b2c3d479
+	//
+	// value is f47ac10b-58cc-4372-a567-0e02b2c3d479
+}
@@ -0,0 +1,56 @@
+package ferrite
+
+import "github.com/dogmatiq/ferrite/internal/variable"
+
+// Constrain adds a constraint involving the values of several variable sets,
+// such as requiring that one value is less than another.
+//
+// desc is a human-readable description of the constraint, such as "POOL_MIN
+// must be less than or equal to POOL_MAX".
+//
+// fn is called after each of the sets has been resolved, and only if all of the
+// sets have valid values. It obtains the sets' values by calling their Value()
+// methods. If fn returns false each of the variables in the sets is considered
+// invalid.
+//
+// It must be called before [Init]. The constraint is checked by [Init] after
+// each variable has been resolved individually. It is not checked when a value
+// is obtained from one of the sets, as fn itself obtains those values.
+func Constrain(
+	desc string,
+	fn func() bool,
+	sets ...AnyVariableSet,
+) {
+	if desc == "" {
+		panic("constraint description must not be empty")
+	}
+
+	if len(sets) == 0 {
+		panic("Constrain() requires at least one variable set")
+	}
+
+	var vars []variable.Any
+	for _, s := range sets {
+		vars = append(vars, s.variables()...)
+	}
+
+	variable.AddCrossConstraint(desc, fn, vars...)
+
+	var relationships []variable.Relationship
+
+	for _, v := range vars {
+		for _, other := range vars {
+			if v != other {
+				relationships = append(
+					relationships,
+					variable.RefersTo{
+						Subject:  v.Spec(),
+						RefersTo: other.Spec(),
+					},
+				)
+			}
+		}
+	}
+
+	variable.EstablishRelationships(relationships...)
+}
@@ -0,0 +1,141 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Constrain()", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			v := String("FERRITE_STRING", "<desc>").Optional()
+			Constrain("", func() bool { return true }, v)
+		}).To(PanicWith("constraint description must not be empty"))
+	})
+
+	It("panics if there are no variable sets", func() {
+		Expect(func() {
+			Constrain("<desc>", func() bool { return true })
+		}).To(PanicWith("Constrain() requires at least one variable set"))
+	})
+
+	It("does not check the constraint when a value is obtained", func() {
+		poolMin := Unsigned[uint]("FERRITE_POOL_MIN", "<desc>").Required()
+		poolMax := Unsigned[uint]("FERRITE_POOL_MAX", "<desc>").Required()
+
+		Constrain(
+			"<desc>",
+			func() bool { return poolMin.Value() <= poolMax.Value() },
+			poolMin,
+			poolMax,
+		)
+
+		os.Setenv("FERRITE_POOL_MIN", "10")
+		os.Setenv("FERRITE_POOL_MAX", "5")
+
+		Expect(poolMin.Value()).To(Equal(uint(10)))
+		Expect(poolMax.Value()).To(Equal(uint(5)))
+	})
+})
+
+func ExampleConstrain() {
+	defer example()()
+
+	poolMin := ferrite.
+		Unsigned[uint]("FERRITE_POOL_MIN", "minimum number of connections").
+		WithDefault(1).
+		Required()
+
+	poolMax := ferrite.
+		Unsigned[uint]("FERRITE_POOL_MAX", "maximum number of connections").
+		Required()
+
+	ferrite.Constrain(
+		"FERRITE_POOL_MIN must be less than or equal to FERRITE_POOL_MAX",
+		func() bool {
+			return poolMin.Value() <= poolMax.Value()
+		},
+		poolMin,
+		poolMax,
+	)
+
+	os.Setenv("FERRITE_POOL_MAX", "10")
+	ferrite.Init()
+
+	fmt.Println("range is", poolMin.Value(), "to", poolMax.Value())
+
+	// Output:
+	// range is 1 to 10
+}
+
+func ExampleConstrain_unsatisfied() {
+	defer example()()
+
+	poolMin := ferrite.
+		Unsigned[uint]("FERRITE_POOL_MIN", "minimum number of connections").
+		WithDefault(5).
+		Required()
+
+	poolMax := ferrite.
+		Unsigned[uint]("FERRITE_POOL_MAX", "maximum number of connections").
+		Required()
+
+	ferrite.Constrain(
+		"FERRITE_POOL_MIN must be less than or equal to FERRITE_POOL_MAX",
+		func() bool {
+			return poolMin.Value() <= poolMax.Value()
+		},
+		poolMin,
+		poolMax,
+	)
+
+	os.Setenv("FERRITE_POOL_MAX", "2")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_POOL_MAX  maximum number of connections    <uint>        ✗ set to 2, FERRITE_POOL_MIN must be less than or equal to FERRITE_POOL_MAX
+	//  ❯ FERRITE_POOL_MIN  minimum number of connections  [ <uint> ] = 5  ✗ using default value, FERRITE_POOL_MIN must be less than or equal to FERRITE_POOL_MAX
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleConstrain_optional() {
+	defer example()()
+
+	read := ferrite.
+		Duration("FERRITE_READ_TIMEOUT", "read timeout").
+		Optional()
+
+	write := ferrite.
+		Duration("FERRITE_WRITE_TIMEOUT", "write timeout").
+		Optional()
+
+	ferrite.Constrain(
+		"FERRITE_READ_TIMEOUT must be less than FERRITE_WRITE_TIMEOUT",
+		func() bool {
+			r, _ := read.Value()
+			w, _ := write.Value()
+			return r < w
+		},
+		read,
+		write,
+	)
+
+	// The constraint is not checked because FERRITE_WRITE_TIMEOUT is undefined.
+	os.Setenv("FERRITE_READ_TIMEOUT", time.Minute.String())
+	ferrite.Init()
+
+	// Output:
+}
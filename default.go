@@ -0,0 +1,46 @@
+package ferrite
+
+import "github.com/dogmatiq/ferrite/internal/variable"
+
+// WithDefaultFrom configures the variable built by b to use a default value
+// that is derived from the value of another variable set, s.
+//
+// fn is called with the value of s each time the variable is resolved while it
+// is undefined. The variable is treated as though it has no default value if s
+// does not have a value.
+//
+// The derived value is subject to the same constraints as any other default
+// value. It is equivalent to calling b.WithDefaultFunc() with a function that
+// obtains the value of s.
+func WithDefaultFrom[T, S any, B interface {
+	WithDefaultFunc(func() (T, bool), ...AnyVariableSet) B
+}](
+	b B,
+	s VariableSet[S],
+	fn func(S) T,
+) B {
+	return b.WithDefaultFunc(
+		func() (T, bool) {
+			if x, ok := s.native(); ok {
+				return fn(x), true
+			}
+
+			var zero T
+			return zero, false
+		},
+		s,
+	)
+}
+
+// specsOf returns the specifications of the variables in the given sets.
+func specsOf(sets []AnyVariableSet) []variable.Spec {
+	var specs []variable.Spec
+
+	for _, s := range sets {
+		for _, v := range s.variables() {
+			specs = append(specs, v.Spec())
+		}
+	}
+
+	return specs
+}
@@ -0,0 +1,259 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func WithDefaultFrom()", func() {
+	var (
+		base    Required[uint16]
+		derived Optional[uint16]
+	)
+
+	BeforeEach(func() {
+		base = Unsigned[uint16]("FERRITE_BASE", "<desc>").
+			WithDefault(100).
+			Required()
+
+		derived = WithDefaultFrom(
+			Unsigned[uint16]("FERRITE_DERIVED", "<desc>").
+				WithMaximum(1000),
+			base,
+			func(v uint16) uint16 {
+				return v + 1
+			},
+		).Optional()
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	When("the variable is undefined", func() {
+		It("returns the derived default value", func() {
+			v, ok := derived.Value()
+			Expect(ok).To(BeTrue())
+			Expect(v).To(BeNumerically("==", 101))
+		})
+
+		It("recomputes the default value when the other variable changes", func() {
+			derived.Value()
+
+			os.Setenv("FERRITE_BASE", "200")
+
+			v, ok := derived.Value()
+			Expect(ok).To(BeTrue())
+			Expect(v).To(BeNumerically("==", 201))
+		})
+
+		It("panics if the derived value does not satisfy the constraints", func() {
+			os.Setenv("FERRITE_BASE", "1000")
+
+			Expect(func() {
+				derived.Value()
+			}).To(PanicWith("default value of FERRITE_DERIVED (1001) is invalid: too high, expected 1000 or less"))
+		})
+	})
+
+	When("the variable is defined", func() {
+		BeforeEach(func() {
+			os.Setenv("FERRITE_DERIVED", "500")
+		})
+
+		It("returns the value from the environment", func() {
+			v, ok := derived.Value()
+			Expect(ok).To(BeTrue())
+			Expect(v).To(BeNumerically("==", 500))
+		})
+	})
+
+	When("the other variable set has no value", func() {
+		It("treats the variable as though it has no default value", func() {
+			other := Unsigned[uint16]("FERRITE_OTHER", "<desc>").
+				Optional()
+
+			v := WithDefaultFrom(
+				Unsigned[uint16]("FERRITE_DERIVED_FROM_OTHER", "<desc>"),
+				other,
+				func(v uint16) uint16 {
+					return v + 1
+				},
+			).Optional()
+
+			_, ok := v.Value()
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("func WithDefaultFunc()", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("derives the members of an enum set", func() {
+		v := EnumSet("FERRITE_ENUMSET", "<desc>").
+			WithMembers("foo", "bar", "baz").
+			WithDefaultFunc(
+				func() ([]string, bool) {
+					return []string{"baz", "foo"}, true
+				},
+			).
+			Required()
+
+		Expect(v.Value().Members()).To(Equal([]string{"foo", "baz"}))
+	})
+
+	It("derives both the host and port of a kubernetes service", func() {
+		v := KubernetesService("ferrite-svc").
+			WithDefaultFunc(
+				func() (KubernetesAddress, bool) {
+					return KubernetesAddress{
+						Host: "host.example.org",
+						Port: "12345",
+					}, true
+				},
+			).
+			Required()
+
+		Expect(v.Value()).To(Equal(
+			KubernetesAddress{
+				Host: "host.example.org",
+				Port: "12345",
+			},
+		))
+	})
+
+	It("replaces a default value that was set previously", func() {
+		v := Regexp("FERRITE_REGEXP", "<desc>").
+			WithDefault("static").
+			WithDefaultFunc(
+				func() (*regexp.Regexp, bool) {
+					return regexp.MustCompile("derived"), true
+				},
+			).
+			Required()
+
+		Expect(v.Value().String()).To(Equal("derived"))
+	})
+
+	It("is replaced by a default value that is set subsequently", func() {
+		v := Regexp("FERRITE_REGEXP", "<desc>").
+			WithDefaultFunc(
+				func() (*regexp.Regexp, bool) {
+					return regexp.MustCompile("derived"), true
+				},
+			).
+			WithDefault("static").
+			Required()
+
+		Expect(v.Value().String()).To(Equal("static"))
+	})
+
+	It("panics if the derived value is invalid", func() {
+		v := URL("FERRITE_URL", "<desc>").
+			WithDefaultFunc(
+				func() (*url.URL, bool) {
+					return &url.URL{Path: "/path"}, true
+				},
+			).
+			Required()
+
+		Expect(func() {
+			v.Value()
+		}).To(PanicWith("default value of FERRITE_URL (/path) is invalid: URL must have a scheme"))
+	})
+})
+
+func ExampleWithDefaultFrom() {
+	defer example()()
+
+	httpPort := ferrite.
+		NetworkPort("FERRITE_HTTP_PORT", "the port for the HTTP server").
+		Required()
+
+	metricsPort := ferrite.
+		WithDefaultFrom(
+			ferrite.NetworkPort("FERRITE_METRICS_PORT", "the port for the metrics server"),
+			httpPort,
+			func(p string) string {
+				n, _ := strconv.Atoi(p)
+				return strconv.Itoa(n + 1)
+			},
+		).
+		Required()
+
+	os.Setenv("FERRITE_HTTP_PORT", "8080")
+	ferrite.Init()
+
+	fmt.Println("metrics port is", metricsPort.Value())
+
+	// Output:
+	// metrics port is 8081
+}
+
+func ExampleDirBuilder_WithDefaultFunc() {
+	defer example()()
+
+	dataDir := ferrite.
+		Dir("FERRITE_DATA_DIR", "the directory in which to store data").
+		Required()
+
+	cacheDir := ferrite.
+		Dir("FERRITE_CACHE_DIR", "the directory in which to store cached data").
+		WithDefaultFunc(
+			func() (string, bool) {
+				return filepath.Join(string(dataDir.Value()), "cache"), true
+			},
+			dataDir,
+		).
+		Required()
+
+	os.Setenv("FERRITE_DATA_DIR", "/var/lib/app")
+	ferrite.Init()
+
+	fmt.Println("cache directory is", cacheDir.Value())
+
+	// Output:
+	// cache directory is /var/lib/app/cache
+}
+
+func ExampleWithDefaultFrom_invalid() {
+	defer example()()
+
+	httpPort := ferrite.
+		Unsigned[uint16]("FERRITE_HTTP_PORT", "the port for the HTTP server").
+		Required()
+
+	ferrite.
+		WithDefaultFrom(
+			ferrite.Unsigned[uint16]("FERRITE_METRICS_PORT", "the port for the metrics server").
+				WithMaximum(9000),
+			httpPort,
+			func(p uint16) uint16 {
+				return p + 1
+			},
+		).
+		Required()
+
+	os.Setenv("FERRITE_HTTP_PORT", "9000")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_HTTP_PORT     the port for the HTTP server       <uint16>                ✓ set to 9000
+	//  ❯ FERRITE_METRICS_PORT  the port for the metrics server  [ ... 9000 ] = <derived>  ✗ using default value of 9001, too high, expected 9000 or less
+	//
+	// <process exited with error code 1>
+}
@@ -0,0 +1,3 @@
+// Package editdistance computes the similarity of strings, for use when
+// suggesting corrections to invalid values.
+package editdistance
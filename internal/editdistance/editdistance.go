@@ -0,0 +1,52 @@
+package editdistance
+
+// Distance returns the "optimal string alignment" distance between a and b.
+//
+// It is the number of single-character insertions, deletions, substitutions
+// and transpositions of adjacent characters required to transform a into b,
+// where no substring is edited more than once.
+func Distance(a, b string) int {
+	x := []rune(a)
+	y := []rune(b)
+
+	// d[i][j] is the distance between the first i runes of x and the first j
+	// runes of y.
+	d := make([][]int, len(x)+1)
+	for i := range d {
+		d[i] = make([]int, len(y)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(x); i++ {
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = min(
+				d[i-1][j]+1,      // deletion
+				d[i][j-1]+1,      // insertion
+				d[i-1][j-1]+cost, // substitution
+			)
+
+			if i > 1 && j > 1 && x[i-1] == y[j-2] && x[i-2] == y[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1) // transposition
+			}
+		}
+	}
+
+	return d[len(x)][len(y)]
+}
+
+// IsNearMiss returns true if a value that is the given distance from candidate
+// is likely to be a mistyped version of candidate.
+//
+// The number of edits that is tolerated grows with the length of candidate.
+func IsNearMiss(candidate string, distance int) bool {
+	n := len([]rune(candidate)) / 3
+	return distance <= max(n, 1)
+}
@@ -0,0 +1,38 @@
+package editdistance_test
+
+import (
+	. "github.com/dogmatiq/ferrite/internal/editdistance"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Distance()", func() {
+	DescribeTable(
+		"it returns the number of edits required to transform one string into another",
+		func(a, b string, expect int) {
+			Expect(Distance(a, b)).To(Equal(expect))
+			Expect(Distance(b, a)).To(Equal(expect))
+		},
+		Entry("identical", "production", "production", 0),
+		Entry("empty", "", "prod", 4),
+		Entry("insertion", "prodution", "production", 1),
+		Entry("substitution", "producton", "production", 1),
+		Entry("transposition", "prdo", "prod", 1),
+		Entry("multiple edits", "kitten", "sitting", 3),
+		Entry("multi-byte characters", "café", "cafe", 1),
+	)
+})
+
+var _ = Describe("func IsNearMiss()", func() {
+	DescribeTable(
+		"it returns true if the value is likely to be a mistyped version of the candidate",
+		func(v, candidate string, expect bool) {
+			Expect(IsNearMiss(candidate, Distance(v, candidate))).To(Equal(expect))
+		},
+		Entry("identical", "prod", "prod", true),
+		Entry("single edit in short candidate", "prdo", "prod", true),
+		Entry("two edits in short candidate", "pdro", "prod", false),
+		Entry("several edits in long candidate", "prodcuton", "production", true),
+		Entry("unrelated", "staging", "production", false),
+	)
+})
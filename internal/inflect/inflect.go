@@ -1,9 +1,44 @@
 package inflect
 
+import "strings"
+
 // Pluralize returns the plural form of word if n is not 1.
 func Pluralize(word string, n int) string {
 	if n == 1 {
 		return word
 	}
+
+	for _, suffix := range []string{"s", "x", "ch", "sh"} {
+		if strings.HasSuffix(word, suffix) {
+			return word + "es"
+		}
+	}
+
 	return word + "s"
 }
+
+// AndList returns a human-readable list of items joined by "and", such as
+// "a, b and c".
+func AndList(items []string) string {
+	switch n := len(items); n {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	default:
+		return strings.Join(items[:n-1], ", ") + " and " + items[n-1]
+	}
+}
+
+// OrList returns a human-readable list of items joined by "or", such as "a, b
+// or c".
+func OrList(items []string) string {
+	switch n := len(items); n {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	default:
+		return strings.Join(items[:n-1], ", ") + " or " + items[n-1]
+	}
+}
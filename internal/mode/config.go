@@ -3,6 +3,7 @@ package mode
 import (
 	"io"
 	"os"
+	"time"
 
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
@@ -10,10 +11,12 @@ import (
 // Config is the configuration used when running a mode.
 type Config struct {
 	Registries variable.RegistrySet
+	AppVersion string
 	Args       []string
 	Out        io.Writer
 	Err        io.Writer
 	Exit       func(int)
+	Now        func() time.Time
 }
 
 // DefaultConfig is the default configuration for running a mode.
@@ -27,6 +30,7 @@ func ResetDefaultConfig() {
 		Out:  os.Stdout,
 		Err:  os.Stderr,
 		Exit: os.Exit,
+		Now:  time.Now,
 	}
 }
 
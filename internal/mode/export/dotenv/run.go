@@ -1,6 +1,9 @@
 package dotenv
 
 import (
+	"time"
+
+	"github.com/dogmatiq/ferrite/internal/inflect"
 	"github.com/dogmatiq/ferrite/internal/mode"
 	"github.com/dogmatiq/ferrite/internal/mode/internal/render"
 	"github.com/dogmatiq/ferrite/internal/variable"
@@ -19,11 +22,17 @@ func Run(cfg mode.Config) {
 
 		must.Fprintf(cfg.Out, "# %s (", s.Description())
 
-		if def, ok := s.Default(); ok {
+		if def, ok := defaultValue(s); ok {
 			must.WriteString(cfg.Out, "default: ")
-			must.WriteString(cfg.Out, render.Value(s, def))
+			must.WriteString(cfg.Out, def)
+
+			if s.IsDeprecated() && s.Deprecation() != (variable.Deprecation{}) {
+				must.Fprintf(cfg.Out, ", deprecated")
+				writeDeprecation(cfg, s)
+			}
 		} else if s.IsDeprecated() {
 			must.Fprintf(cfg.Out, "deprecated")
+			writeDeprecation(cfg, s)
 		} else if s.IsRequired() {
 			must.Fprintf(cfg.Out, "required")
 		} else {
@@ -35,6 +44,7 @@ func Run(cfg mode.Config) {
 		}
 
 		must.Fprintf(cfg.Out, ")\n")
+		writeProfileDefaults(cfg, s)
 		must.Fprintf(cfg.Out, "export %s=", s.Name())
 
 		if v.Source() == variable.SourceEnvironment {
@@ -63,6 +73,8 @@ func Run(cfg mode.Config) {
 					)
 				}
 			}
+		} else if v.Source() == variable.SourceDefault && isComputedDefault(s) {
+			writeComputedDefault(cfg, v)
 		}
 
 		must.Fprintf(cfg.Out, "\n")
@@ -70,3 +82,92 @@ func Run(cfg mode.Config) {
 
 	cfg.Exit(0)
 }
+
+// defaultValue returns a description of the default value of s.
+func defaultValue(s variable.Spec) (string, bool) {
+	if def, ok := s.Default(); ok {
+		return render.Value(s, def), true
+	}
+
+	if !s.HasDerivedDefault() {
+		return "", false
+	}
+
+	var names []string
+	for _, rel := range variable.Relationships[variable.DerivesDefaultFrom](s) {
+		names = append(names, rel.DerivesDefaultFrom.Name())
+	}
+
+	if len(names) == 0 {
+		return "computed at runtime", true
+	}
+
+	return "derived from " + inflect.AndList(names), true
+}
+
+// writeProfileDefaults writes the default values of s that apply when each of
+// its profiles is active.
+func writeProfileDefaults(cfg mode.Config, s variable.Spec) {
+	for _, rel := range variable.Relationships[variable.ProfileDefault](s) {
+		must.Fprintf(
+			cfg.Out,
+			"# default when %s is %s: %s\n",
+			rel.Profile.Selector.Name(),
+			render.Value(rel.Profile.Selector, rel.Profile.Value),
+			render.Value(s, rel.Default),
+		)
+	}
+}
+
+// isComputedDefault returns true if the current default value of s is not its
+// static default value, either because it is derived from other variables or
+// because one of its profiles is active.
+func isComputedDefault(s variable.Spec) bool {
+	for _, rel := range variable.Relationships[variable.ProfileDefault](s) {
+		if rel.Profile.IsActive() {
+			return true
+		}
+	}
+
+	return s.HasDerivedDefault()
+}
+
+// writeComputedDefault writes the current default value of v, which is either
+// derived from other variables or taken from the active profile.
+func writeComputedDefault(cfg mode.Config, v variable.Any) {
+	s := v.Spec()
+
+	if err, ok := v.Error().(variable.ValueError); ok {
+		must.Fprintf(
+			cfg.Out,
+			" # default of %s is invalid: %s",
+			render.Value(s, err.Literal()),
+			err.Unwrap(),
+		)
+		return
+	}
+
+	must.Fprintf(
+		cfg.Out,
+		" # defaults to %s",
+		render.Value(s, v.Value().Canonical()),
+	)
+}
+
+// writeDeprecation writes information about the lifecycle of the deprecated
+// variable s.
+func writeDeprecation(cfg mode.Config, s variable.Spec) {
+	d := s.Deprecation()
+
+	if d.Since != "" {
+		must.Fprintf(cfg.Out, " since %s", d.Since)
+	}
+
+	if d.RemovedIn != "" {
+		must.Fprintf(cfg.Out, ", removed in %s", d.RemovedIn)
+	}
+
+	if !d.Sunset.IsZero() {
+		must.Fprintf(cfg.Out, ", sunset after %s", d.Sunset.Format(time.DateOnly))
+	}
+}
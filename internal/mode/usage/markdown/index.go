@@ -36,8 +36,14 @@ func (r *renderer) renderIndex() {
 		if s.IsDeprecated() {
 			name = "~~" + name + "~~"
 			usage = "optional, deprecated"
+		} else if len(variable.Relationships[variable.ProfileDefault](s)) != 0 {
+			usage = "defaults vary by profile"
+		} else if s.HasDerivedDefault() {
+			usage = "defaults to a value " + r.renderDerivedDefault(s)
 		} else if def, ok := s.Default(); ok {
 			usage = fmt.Sprintf("defaults to `%s`", def.Quote())
+		} else if isConditionallyRequired(s) {
+			usage = "conditional"
 		} else if !s.IsRequired() {
 			usage = "optional"
 		} else if len(variable.Relationships[variable.DependsOn](s)) != 0 {
@@ -57,3 +63,30 @@ func (r *renderer) renderIndex() {
 
 	t.WriteTo(r.Output)
 }
+
+// renderDerivedDefault returns a fragment describing where the derived default
+// value of s comes from, such as "derived from [`HTTP_PORT`]".
+func (r *renderer) renderDerivedDefault(s variable.Spec) string {
+	rels := variable.Relationships[variable.DerivesDefaultFrom](s)
+	if len(rels) == 0 {
+		return "computed at runtime"
+	}
+
+	return "derived from " + andList(
+		rels,
+		func(rel variable.DerivesDefaultFrom) string {
+			return r.linkToSpec(rel.DerivesDefaultFrom)
+		},
+	)
+}
+
+// isConditionallyRequired returns true if s is required when some other
+// variable has a specific value.
+func isConditionallyRequired(s variable.Spec) bool {
+	for _, rel := range variable.Relationships[variable.DependsOn](s) {
+		if rel.Required {
+			return true
+		}
+	}
+	return false
+}
@@ -1,11 +1,80 @@
 package markdown
 
 import (
+	"fmt"
 	"strings"
 
+	"github.com/dogmatiq/ferrite/internal/inflect"
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
 
+func (r *specRenderer) renderExclusions() {
+	var exactlyOne, atMostOne []variable.Spec
+
+	for _, rel := range variable.Exclusions(r.spec) {
+		if rel.ExactlyOne {
+			exactlyOne = append(exactlyOne, rel.MutuallyExclusiveWith)
+		} else {
+			atMostOne = append(atMostOne, rel.MutuallyExclusiveWith)
+		}
+	}
+
+	if len(exactlyOne) == 0 && len(atMostOne) == 0 {
+		return
+	}
+
+	r.ren.paragraph(
+		func(write func(string, ...any)) {
+			sep := ""
+
+			if len(exactlyOne) != 0 {
+				names := []string{fmt.Sprintf("`%s`", r.spec.Name())}
+				for _, s := range exactlyOne {
+					names = append(names, r.ren.linkToSpec(s))
+				}
+
+				write(
+					"Exactly one of %s **MUST** be defined.",
+					inflect.AndList(names),
+				)
+				sep = " "
+			}
+
+			if len(atMostOne) != 0 {
+				write(
+					"%sThe `%s` variable **MUST NOT** be defined when %s is defined.",
+					sep,
+					r.spec.Name(),
+					orList(atMostOne, r.ren.linkToSpec),
+				)
+			}
+		},
+	)
+}
+
+func (r *specRenderer) renderCrossConstraints() {
+	for _, c := range r.spec.CrossConstraints() {
+		names := []string{fmt.Sprintf("`%s`", r.spec.Name())}
+		for _, v := range c.Variables() {
+			if s := v.Spec(); s != r.spec {
+				names = append(names, r.ren.linkToSpec(s))
+			}
+		}
+
+		subject := "The value of " + names[0]
+		if len(names) > 1 {
+			subject = "The values of " + inflect.AndList(names)
+		}
+
+		r.ren.paragraphf(
+			"%s **MUST** satisfy the following constraint: %s.",
+		)(
+			subject,
+			strings.TrimSuffix(c.Description(), "."),
+		)
+	}
+}
+
 func (r *specRenderer) renderSeeAlso() {
 	relationships := variable.Relationships[variable.RefersTo](r.spec)
 	if len(relationships) == 0 {
@@ -16,8 +85,13 @@ func (r *specRenderer) renderSeeAlso() {
 	r.ren.line("### See Also")
 	r.ren.gap()
 
+	seen := map[variable.Spec]struct{}{}
+
 	for _, rel := range relationships {
-		r.ren.renderSeeAlsoItem(rel.RefersTo)
+		if _, ok := seen[rel.RefersTo]; !ok {
+			seen[rel.RefersTo] = struct{}{}
+			r.ren.renderSeeAlsoItem(rel.RefersTo)
+		}
 	}
 }
 
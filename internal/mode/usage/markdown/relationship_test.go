@@ -1,6 +1,9 @@
 package markdown_test
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/dogmatiq/ferrite"
 	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
 	. "github.com/onsi/ginkgo/v2"
@@ -29,6 +32,64 @@ var _ = DescribeTable(
 				)
 		},
 	),
+	Entry(
+		"mutually exclusive",
+		"mutually-exclusive.md",
+		func(reg ferrite.Registry) {
+			token := ferrite.
+				String("AUTH_TOKEN", "authentication token").
+				Optional(ferrite.WithRegistry(reg))
+
+			ferrite.
+				File("AUTH_TOKEN_FILE", "file containing the authentication token").
+				Optional(
+					ferrite.WithRegistry(reg),
+					ferrite.MutuallyExclusiveWith(token),
+				)
+		},
+	),
+	Entry(
+		"exactly one of",
+		"exactly-one-of.md",
+		func(reg ferrite.Registry) {
+			token := ferrite.
+				String("AUTH_TOKEN", "authentication token").
+				Optional(ferrite.WithRegistry(reg))
+
+			tokenFile := ferrite.
+				File("AUTH_TOKEN_FILE", "file containing the authentication token").
+				Optional(ferrite.WithRegistry(reg))
+
+			password := ferrite.
+				String("AUTH_PASSWORD", "authentication password").
+				Optional(ferrite.WithRegistry(reg))
+
+			ferrite.ExactlyOneOf(token, tokenFile, password)
+		},
+	),
+	Entry(
+		"cross constraint",
+		"cross-constraint.md",
+		func(reg ferrite.Registry) {
+			poolMin := ferrite.
+				Unsigned[uint]("POOL_MIN", "minimum number of connections").
+				WithDefault(1).
+				Required(ferrite.WithRegistry(reg))
+
+			poolMax := ferrite.
+				Unsigned[uint]("POOL_MAX", "maximum number of connections").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.Constrain(
+				"POOL_MIN must be less than or equal to POOL_MAX",
+				func() bool {
+					return poolMin.Value() <= poolMax.Value()
+				},
+				poolMin,
+				poolMax,
+			)
+		},
+	),
 	Entry(
 		"deprecated + superseded",
 		"deprecated-superseded.md",
@@ -242,4 +303,214 @@ var _ = DescribeTable(
 				)
 		},
 	),
+	Entry(
+		"depends on + required (tri-state)",
+		"depends-on/required-tri-state.md",
+		func(reg ferrite.Registry) {
+			widgetEnabled := ferrite.
+				TriState("WIDGET_ENABLED", "enable the widget").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				String("WIDGET_COLOR", "the color of the widget").
+				Required(
+					ferrite.WithRegistry(reg),
+					ferrite.RelevantIf(widgetEnabled),
+				)
+		},
+	),
+	Entry(
+		"required if",
+		"depends-on/required-if.md",
+		func(reg ferrite.Registry) {
+			widgetEnabled := ferrite.
+				Bool("WIDGET_ENABLED", "enable the widget").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				String("WIDGET_COLOR", "the color of the widget").
+				Optional(
+					ferrite.WithRegistry(reg),
+					ferrite.RequiredIf(widgetEnabled),
+				)
+		},
+	),
+	Entry(
+		"required when",
+		"depends-on/required-when.md",
+		func(reg ferrite.Registry) {
+			backend := ferrite.
+				Enum("EMAIL_BACKEND", "the email delivery mechanism").
+				WithMembers("smtp", "sendmail").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				String("SMTP_PASSWORD", "SMTP password").
+				WithSensitiveContent().
+				Optional(
+					ferrite.WithRegistry(reg),
+					ferrite.RequiredWhen(backend, "smtp"),
+				)
+		},
+	),
+	Entry(
+		"depends on + unless",
+		"depends-on/unless.md",
+		func(reg ferrite.Registry) {
+			quiet := ferrite.
+				Bool("QUIET", "suppress all output").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				Bool("VERBOSE", "enable verbose logging").
+				Optional(
+					ferrite.WithRegistry(reg),
+					ferrite.RelevantUnless(quiet),
+				)
+		},
+	),
+	Entry(
+		"depends on + any value",
+		"depends-on/any-value.md",
+		func(reg ferrite.Registry) {
+			widgetMode := ferrite.
+				Enum("WIDGET_MODE", "set the widget mode").
+				WithMembers("stationary", "rolling", "flying").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				Unsigned[uint]("WIDGET_SPEED", "set the speed of the widget").
+				Optional(
+					ferrite.WithRegistry(reg),
+					ferrite.RelevantWhenAny(widgetMode, "rolling", "flying"),
+				)
+		},
+	),
+	Entry(
+		"depends on + predicate",
+		"depends-on/predicate.md",
+		func(reg ferrite.Registry) {
+			workers := ferrite.
+				Unsigned[uint]("WORKERS", "number of worker processes").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				String("COORDINATOR", "address of the worker coordinator").
+				Required(
+					ferrite.WithRegistry(reg),
+					ferrite.RelevantWhenFunc(
+						workers,
+						func(n uint) bool { return n > 1 },
+						"is greater than 1",
+					),
+				)
+		},
+	),
+	Entry(
+		"depends on + any/all",
+		"depends-on/any-all.md",
+		func(reg ferrite.Registry) {
+			debug := ferrite.
+				Bool("DEBUG", "enable debugging features").
+				Required(ferrite.WithRegistry(reg))
+
+			verbose := ferrite.
+				Bool("VERBOSE", "enable verbose logging").
+				Required(ferrite.WithRegistry(reg))
+
+			widgetMode := ferrite.
+				Enum("WIDGET_MODE", "set the widget mode").
+				WithMembers("stationary", "rolling", "flying").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				Bool("WIDGET_TRACE", "trace the widget's movements").
+				Optional(
+					ferrite.WithRegistry(reg),
+					ferrite.RelevantWhen(widgetMode, "flying"),
+					ferrite.RelevantIfAny(
+						ferrite.RelevantIf(debug),
+						ferrite.RelevantIfAll(
+							ferrite.RelevantIf(verbose),
+							ferrite.RelevantUnless(debug),
+						),
+					),
+				)
+		},
+	),
+	Entry(
+		"derived default",
+		"derived-default.md",
+		func(reg ferrite.Registry) {
+			httpPort := ferrite.
+				NetworkPort("HTTP_PORT", "the port for the HTTP server").
+				WithDefault("8080").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				WithDefaultFrom(
+					ferrite.NetworkPort("METRICS_PORT", "the port for the metrics server"),
+					httpPort,
+					func(p string) string {
+						n, _ := strconv.Atoi(p)
+						return strconv.Itoa(n + 1)
+					},
+				).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"profile defaults",
+		"profile.md",
+		func(reg ferrite.Registry) {
+			env := ferrite.
+				Enum("APP_ENV", "the environment in which the application is running").
+				WithMembers("development", "staging", "production").
+				WithDefault("development").
+				Required(ferrite.WithRegistry(reg))
+
+			development := ferrite.ProfileWhen(env, "development")
+			staging := ferrite.ProfileWhen(env, "staging")
+			production := ferrite.ProfileWhen(env, "production")
+
+			ferrite.
+				NetworkPort("HTTP_PORT", "the port for the HTTP server").
+				WithDefault("8080").
+				WithDefaultIn(production, "80").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				Bool("DEBUG", "enable debugging features").
+				WithDefaultIn(development, true).
+				WithDefaultIn(staging, false).
+				WithDefaultIn(production, false).
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				String("SENTRY_DSN", "the DSN used to report errors to Sentry").
+				Optional(
+					ferrite.WithRegistry(reg),
+					ferrite.RequiredIn(staging, production),
+				)
+		},
+	),
+	Entry(
+		"deprecated + lifecycle",
+		"deprecated-lifecycle.md",
+		func(reg ferrite.Registry) {
+			verbose := ferrite.
+				Bool("VERBOSE", "enable verbose logging").
+				Optional(ferrite.WithRegistry(reg))
+
+			ferrite.
+				Bool("DEBUG", "enable debug logging").
+				Deprecated(
+					ferrite.WithRegistry(reg),
+					ferrite.SupersededBy(verbose),
+					ferrite.DeprecatedSince("v1.4.0"),
+					ferrite.RemovedIn("v2.0.0"),
+					ferrite.SunsetAfter(time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)),
+				)
+		},
+	),
 )
@@ -3,6 +3,7 @@ package markdown
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/dogmatiq/ferrite/internal/inflect"
 	"github.com/dogmatiq/ferrite/internal/variable"
@@ -22,6 +23,9 @@ func (r *specRenderer) Render() {
 	r.ren.line("> %s", r.spec.Description())
 
 	r.spec.Schema().AcceptVisitor(r)
+	r.renderProfileDefaults()
+	r.renderExclusions()
+	r.renderCrossConstraints()
 
 	r.renderImportantDocumentation()
 
@@ -55,28 +59,63 @@ func (r *specRenderer) VisitNumeric(s variable.Numeric) {
 	min, hasMin := s.Min()
 	max, hasMax := s.Max()
 
+	var reqs []string
+
 	if hasMin && hasMax {
-		r.renderPrimaryRequirement("**MUST** be between `%s` and `%s`", min.String, max.String)
+		reqs = append(reqs, fmt.Sprintf("**MUST** be between `%s` and `%s`", min.String, max.String))
 	} else if hasMin {
-		r.renderPrimaryRequirement("**MUST** be `%s` or greater", min.String)
+		reqs = append(reqs, fmt.Sprintf("**MUST** be `%s` or greater", min.String))
 	} else if hasMax {
-		r.renderPrimaryRequirement("**MUST** be `%s` or less", max.String)
-	} else {
-		switch s.Type().Kind() {
+		reqs = append(reqs, fmt.Sprintf("**MUST** be `%s` or less", max.String))
+	}
+
+	kind := s.Type().Kind()
+	isFloat := kind == reflect.Float32 || kind == reflect.Float64
+
+	if isFloat && hasMin != hasMax {
+		// A range with both limits excludes the non-finite values implicitly.
+		reqs = append(reqs, "**MUST** be finite")
+	}
+
+	if m, ok := s.MultipleOf(); ok {
+		reqs = append(reqs, fmt.Sprintf("**MUST** be a multiple of `%s`", m.String))
+	}
+
+	if s.IsPowerOfTwo() {
+		reqs = append(reqs, "**MUST** be a power of two")
+	}
+
+	if len(reqs) == 0 {
+		switch kind {
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			r.renderPrimaryRequirement("**MUST** be a whole number")
+			reqs = append(reqs, "**MUST** be a whole number")
 		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-			r.renderPrimaryRequirement("**MUST** be a non-negative whole number")
+			reqs = append(reqs, "**MUST** be a non-negative whole number")
 		case reflect.Float32, reflect.Float64:
-			r.renderPrimaryRequirement("**MUST** be a number with an **OPTIONAL** fractional part")
+			reqs = append(reqs, "**MUST** be a finite number with an **OPTIONAL** fractional part")
 		}
 	}
+
+	r.renderPrimaryRequirement(
+		"%s",
+		andList(
+			reqs,
+			func(req string) string {
+				return req
+			},
+		),
+	)
 }
 
 // VisitSet renders the primary requirement for a spec that uses the "set"
 // schema type.
 func (r *specRenderer) VisitSet(s variable.Set) {
-	if lits := s.Literals(); len(lits) == 2 {
+	if ext, ok := s.(variable.ExtendedSet); ok {
+		r.renderPrimaryRequirement(
+			"**MUST** be one of the values shown in the examples below, or %s",
+			ext.ExtensionDescription(),
+		)
+	} else if lits := s.Literals(); len(lits) == 2 {
 		r.renderPrimaryRequirement(
 			"**MUST** be either `%s` or `%s`",
 			lits[0].String,
@@ -85,16 +124,81 @@ func (r *specRenderer) VisitSet(s variable.Set) {
 	} else {
 		r.renderPrimaryRequirement("**MUST** be one of the values shown in the examples below")
 	}
+
+	r.renderSetAliases(s)
+}
+
+// renderSetAliases renders a table of the aliases that are accepted in place
+// of the members of a "set" schema, along with information about the set's
+// case sensitivity.
+func (r *specRenderer) renderSetAliases(s variable.Set) {
+	var t table
+	t.AddRow("Value", "Aliases")
+
+	hasAliases := false
+	for _, lit := range s.Literals() {
+		aliases := s.Aliases(lit)
+		if len(aliases) == 0 {
+			continue
+		}
+
+		hasAliases = true
+		t.AddRow(
+			fmt.Sprintf("`%s`", lit.String),
+			inlineList(
+				aliases,
+				func(a variable.Literal) string {
+					return fmt.Sprintf("`%s`", a.String)
+				},
+				", ",
+				", ",
+			),
+		)
+	}
+
+	if hasAliases {
+		if s.IsCaseSensitive() {
+			r.ren.paragraphf(
+				"Each of the aliases shown below may be used in place of the corresponding value.",
+			)()
+		} else {
+			r.ren.paragraphf(
+				"Values are not case-sensitive.",
+				"Each of the aliases shown below may be used in place of the corresponding value.",
+			)()
+		}
+
+		r.ren.gap()
+		t.WriteTo(r.ren.Output)
+	} else if !s.IsCaseSensitive() {
+		r.ren.paragraphf("Values are not case-sensitive.")()
+	}
 }
 
 // VisitString renders the primary requirement for a spec that uses the "string"
 // schema type.
-func (r *specRenderer) VisitString(variable.String) {
+func (r *specRenderer) VisitString(s variable.String) {
+	var reqs []string
+
+	for _, rule := range s.Rules() {
+		rr := &stringRuleRenderer{}
+		rule.AcceptVisitor(rr)
+		reqs = append(reqs, rr.Output)
+	}
+
 	if con := r.bestConstraint(); con != nil {
-		r.renderPrimaryRequirement("%s", con.Description())
-	} else {
-		r.renderPrimaryRequirement("")
+		reqs = append(reqs, con.Description())
 	}
+
+	r.renderPrimaryRequirement(
+		"%s",
+		andList(
+			reqs,
+			func(req string) string {
+				return req
+			},
+		),
+	)
 }
 
 // VisitOther render the primary requirement for a spec that uses the "other"
@@ -109,6 +213,28 @@ func (r *specRenderer) VisitOther(variable.Other) {
 	r.renderPrimaryRequirement("%s", con)
 }
 
+// stringRuleRenderer renders a requirement that completes the phrase "the
+// value..." for each of the rules of a string schema.
+type stringRuleRenderer struct {
+	Output string
+}
+
+func (r *stringRuleRenderer) VisitPatternRule(rule variable.PatternRule) {
+	r.Output = fmt.Sprintf("**MUST** match the regular expression `%s`", rule.Pattern)
+}
+
+func (r *stringRuleRenderer) VisitPrefixRule(rule variable.PrefixRule) {
+	r.Output = fmt.Sprintf("**MUST** begin with `%s`", rule.Prefix)
+}
+
+func (r *stringRuleRenderer) VisitCharsetRule(rule variable.CharsetRule) {
+	r.Output = fmt.Sprintf("**MUST** contain only %s", rule.Charset.Description())
+}
+
+func (r *stringRuleRenderer) VisitNoWhitespaceRule(variable.NoWhitespaceRule) {
+	r.Output = "**MUST NOT** contain whitespace"
+}
+
 // renderPrimaryRequirement renders information about the most important
 // requirement of the variable's schema, this includes information about whether
 // the variable is optional and the basic data type of the variable.
@@ -203,19 +329,30 @@ func (r *specRenderer) renderPrimaryRequirementRequired(req string) {
 func (r *specRenderer) renderPrimaryRequirementOptional(req string) {
 	r.ren.paragraph(
 		func(write func(string, ...any)) {
-			write(
-				"The `%s` variable **MAY** be left undefined.",
-				r.spec.Name(),
-			)
+			connective := "Otherwise,"
+
+			if cond, ok := r.renderRequiredCondition(); ok {
+				write(
+					"The `%s` variable **MUST** be defined when %s, and **MAY** be left undefined otherwise.",
+					r.spec.Name(),
+					cond,
+				)
+				connective = "If defined,"
+			} else {
+				write(
+					"The `%s` variable **MAY** be left undefined.",
+					r.spec.Name(),
+				)
+			}
 
 			length := r.renderLengthClause(r.spec.Schema(), false)
 
 			if req != "" && length != "" {
-				write(" Otherwise, the value %s with %s.", req, length)
+				write(" %s the value %s with %s.", connective, req, length)
 			} else if req != "" {
-				write(" Otherwise, the value %s.", req)
+				write(" %s the value %s.", connective, req)
 			} else if length != "" {
-				write(" Otherwise, the value **MUST** have %s.", length)
+				write(" %s the value **MUST** have %s.", connective, length)
 			}
 
 			if dependsOn, ok := r.renderDependsOnConditionSentence(); ok {
@@ -228,11 +365,32 @@ func (r *specRenderer) renderPrimaryRequirementOptional(req string) {
 func (r *specRenderer) renderPrimaryRequirementDeprecated(req string) {
 	r.ren.paragraph(
 		func(write func(string, ...any)) {
+			d := r.spec.Deprecation()
+
+			since := ""
+			if d.Since != "" {
+				since = fmt.Sprintf(" as of version `%s`", d.Since)
+			}
+
+			removal := "it may be removed in a future version"
+			if d.RemovedIn != "" {
+				removal = fmt.Sprintf("it will be removed in version `%s`", d.RemovedIn)
+			}
+
 			write(
-				"⚠️ The `%s` variable is **deprecated**; its use is **NOT RECOMMENDED** as it may be removed in a future version.",
+				"⚠️ The `%s` variable is **deprecated**%s; its use is **NOT RECOMMENDED** as %s.",
 				r.spec.Name(),
+				since,
+				removal,
 			)
 
+			if !d.Sunset.IsZero() {
+				write(
+					" It **MUST NOT** be used after %s.",
+					d.Sunset.Format(time.DateOnly),
+				)
+			}
+
 			relationships := variable.InverseRelationships[variable.Supersedes](r.spec)
 			if len(relationships) != 0 {
 				write(
@@ -264,6 +422,14 @@ func (r *specRenderer) renderPrimaryRequirementDeprecated(req string) {
 }
 
 func (r *specRenderer) renderDefaultValueFragment() (string, bool) {
+	if len(variable.Relationships[variable.ProfileDefault](r.spec)) != 0 {
+		return "the default value for the active profile", true
+	}
+
+	if r.spec.HasDerivedDefault() {
+		return fmt.Sprintf("a default value %s", r.ren.renderDerivedDefault(r.spec)), true
+	}
+
 	def, ok := r.spec.Default()
 	if !ok {
 		return "", false
@@ -276,6 +442,59 @@ func (r *specRenderer) renderDefaultValueFragment() (string, bool) {
 	return fmt.Sprintf("the default value of `%s`", def.String), true
 }
 
+// renderProfileDefaults renders a table of the default values that apply in
+// each profile, if the variable has any profile-specific default values.
+func (r *specRenderer) renderProfileDefaults() {
+	rels := variable.Relationships[variable.ProfileDefault](r.spec)
+	if len(rels) == 0 {
+		return
+	}
+
+	var selectors []variable.Spec
+	seen := map[variable.Spec]struct{}{}
+
+	for _, rel := range rels {
+		if _, ok := seen[rel.Profile.Selector]; !ok {
+			seen[rel.Profile.Selector] = struct{}{}
+			selectors = append(selectors, rel.Profile.Selector)
+		}
+	}
+
+	r.ren.paragraphf(
+		"The default value depends on the profile selected by %s:",
+	)(
+		orList(selectors, r.ren.linkToSpec),
+	)
+
+	value := func(lit variable.Literal) string {
+		if r.spec.IsSensitive() {
+			return "*(sensitive)*"
+		}
+		return fmt.Sprintf("`%s`", lit.String)
+	}
+
+	var t table
+	t.AddRow("Profile", "Default Value")
+
+	for _, rel := range rels {
+		t.AddRow(
+			fmt.Sprintf("`%s`", rel.Profile.Value.String),
+			value(rel.Default),
+		)
+	}
+
+	if def, ok := r.spec.Default(); ok {
+		t.AddRow("*(any other)*", value(def))
+	} else if r.spec.HasDerivedDefault() {
+		t.AddRow("*(any other)*", "a value "+r.ren.renderDerivedDefault(r.spec))
+	} else {
+		t.AddRow("*(any other)*", "*(none)*")
+	}
+
+	r.ren.gap()
+	t.WriteTo(r.ren.Output)
+}
+
 func (r *specRenderer) renderDependsOnConditionSentence() (string, bool) {
 	if cond, ignored, ok := r.renderDependsOnCondition(); ok {
 		if ignored {
@@ -288,74 +507,194 @@ func (r *specRenderer) renderDependsOnConditionSentence() (string, bool) {
 }
 
 func (r *specRenderer) renderDependsOnCondition() (clause string, ignored, ok bool) {
-	rels := variable.Relationships[variable.DependsOn](r.spec)
+	var rels []variable.DependsOn
+	simple := true
+
+	for _, rel := range variable.Relationships[variable.DependsOn](r.spec) {
+		if rel.Required {
+			continue
+		}
+
+		rels = append(rels, rel)
+
+		if rel.Group != 0 || rel.Negated || rel.Description != "" || len(rel.Values) > 1 {
+			simple = false
+		}
+	}
+
+	if len(rels) == 0 {
+		return "", false, false
+	}
+
+	if !simple {
+		return r.renderComplexDependsOnCondition(rels), false, true
+	}
 
-	var hasZeroes, hasValues bool
+	// If any of the dependencies are satisfied by any "truthy" value, the
+	// condition is described in terms of when the variable is ignored.
+	hasZeroes := false
 	for _, rel := range rels {
-		if rel.Value.IsEmpty() {
+		if len(rel.Values) == 0 {
 			hasZeroes = true
-		} else {
-			hasValues = true
 		}
 	}
 
-	if hasValues && hasZeroes {
+	if hasZeroes {
 		return orList(
 			rels,
 			func(rel variable.DependsOn) string {
-				if v, ok := rel.Value.Get(); ok {
-					return fmt.Sprintf(
-						"%s is not `%s`",
-						r.ren.linkToSpec(rel.DependsOn),
-						v.Quote(),
-					)
-				}
-
-				return fmt.Sprintf(
-					"%s is `%s`",
-					r.ren.linkToSpec(rel.DependsOn),
-					rel.DependsOn.Zero().Quote(),
-				)
+				return r.renderDependency(rel, true)
 			},
 		), true, true
 	}
 
-	if hasZeroes {
-		return orList(
-			rels,
-			func(rel variable.DependsOn) string {
-				v := rel.DependsOn.Zero()
+	return andList(
+		rels,
+		func(rel variable.DependsOn) string {
+			return r.renderDependency(rel, false)
+		},
+	), false, true
+}
+
+// renderComplexDependsOnCondition renders a clause describing the conditions
+// under which the variable is used, when those conditions can not be described
+// in terms of when the variable is ignored.
+func (r *specRenderer) renderComplexDependsOnCondition(rels []variable.DependsOn) string {
+	type group struct {
+		alternatives [][]variable.DependsOn
+	}
+
+	var groups []*group
+	index := map[int]*group{}
+
+	for _, rel := range rels {
+		g, ok := index[rel.Group]
+		if !ok || rel.Group == 0 {
+			g = &group{}
+			groups = append(groups, g)
+			if rel.Group != 0 {
+				index[rel.Group] = g
+			}
+		}
+
+		for len(g.alternatives) <= rel.Alternative {
+			g.alternatives = append(g.alternatives, nil)
+		}
+
+		g.alternatives[rel.Alternative] = append(g.alternatives[rel.Alternative], rel)
+	}
 
-				if v.String == "" {
-					return fmt.Sprintf(
-						"%s is undefined",
-						r.ren.linkToSpec(rel.DependsOn),
+	return andList(
+		groups,
+		func(g *group) string {
+			clause := orList(
+				g.alternatives,
+				func(alt []variable.DependsOn) string {
+					clause := andList(
+						alt,
+						func(rel variable.DependsOn) string {
+							return r.renderDependency(rel, false)
+						},
 					)
-				}
 
-				return fmt.Sprintf(
-					"%s is `%s`",
-					r.ren.linkToSpec(rel.DependsOn),
-					v.Quote(),
-				)
-			},
-		), true, true
+					switch {
+					case len(g.alternatives) == 1 || len(alt) == 1:
+						return clause
+					case len(alt) == 2:
+						return "both " + clause
+					default:
+						return "all of " + clause
+					}
+				},
+			)
+
+			if len(g.alternatives) > 1 && len(groups) > 1 {
+				return "either " + clause
+			}
+
+			return clause
+		},
+	)
+}
+
+// renderDependency renders a clause describing the condition that rel places
+// on its dependency, or the inverse of that condition if negate is true.
+func (r *specRenderer) renderDependency(rel variable.DependsOn, negate bool) string {
+	link := r.ren.linkToSpec(rel.DependsOn)
+	negate = negate != rel.Negated
+
+	if rel.Description != "" {
+		if negate {
+			return fmt.Sprintf("it is not the case that %s %s", link, rel.Description)
+		}
+		return fmt.Sprintf("%s %s", link, rel.Description)
 	}
 
-	if hasValues {
-		return andList(
-			rels,
-			func(rel variable.DependsOn) string {
-				return fmt.Sprintf(
-					"%s is `%s`",
-					r.ren.linkToSpec(rel.DependsOn),
-					rel.Value.MustGet().Quote(),
-				)
+	values := func(sep string) string {
+		return inlineList(
+			rel.Values,
+			func(v variable.Literal) string {
+				return fmt.Sprintf("`%s`", v.Quote())
 			},
-		), false, true
+			", ",
+			sep,
+		)
+	}
+
+	switch n := len(rel.Values); {
+	case n == 0:
+		v := rel.DependsOn.Zero()
+		if v.String == "" {
+			if negate {
+				return fmt.Sprintf("%s is undefined", link)
+			}
+			return fmt.Sprintf("%s is defined", link)
+		}
+		if negate {
+			return fmt.Sprintf("%s is `%s`", link, v.Quote())
+		}
+		return fmt.Sprintf("%s is not `%s`", link, v.Quote())
+
+	case n == 1:
+		if negate {
+			return fmt.Sprintf("%s is not %s", link, values(""))
+		}
+		return fmt.Sprintf("%s is %s", link, values(""))
+
+	case n == 2:
+		if negate {
+			return fmt.Sprintf("%s is neither %s", link, values(" nor "))
+		}
+		return fmt.Sprintf("%s is either %s", link, values(" or "))
+
+	default:
+		if negate {
+			return fmt.Sprintf("%s is not one of %s", link, values(" or "))
+		}
+		return fmt.Sprintf("%s is one of %s", link, values(" or "))
+	}
+}
+
+// renderRequiredCondition renders a clause describing the conditions under
+// which an optional variable is required.
+func (r *specRenderer) renderRequiredCondition() (string, bool) {
+	var rels []variable.DependsOn
+	for _, rel := range variable.Relationships[variable.DependsOn](r.spec) {
+		if rel.Required {
+			rels = append(rels, rel)
+		}
 	}
 
-	return "", false, false
+	if len(rels) == 0 {
+		return "", false
+	}
+
+	return orList(
+		rels,
+		func(rel variable.DependsOn) string {
+			return r.renderDependency(rel, false)
+		},
+	), true
 }
 
 // bestConstraint returns the constraint to use as the "primary"
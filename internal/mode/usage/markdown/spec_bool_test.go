@@ -61,4 +61,14 @@ var _ = DescribeTable(
 				Required(ferrite.WithRegistry(reg))
 		},
 	),
+	Entry(
+		"required with flexible literals",
+		"with-flexible-literals.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Bool("DEBUG", "enable or disable debugging features").
+				WithFlexibleLiterals().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
 )
@@ -0,0 +1,47 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"cron schedule spec",
+	tableTest(
+		"spec/cron",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				CronSchedule("REPORT_SCHEDULE", "the schedule on which reports are generated").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				CronSchedule("REPORT_SCHEDULE", "the schedule on which reports are generated").
+				WithDefault("0 6 * * MON-FRI").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with examples",
+		"with-examples.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				CronSchedule("REPORT_SCHEDULE", "the schedule on which reports are generated").
+				WithExample("@weekly", "generate reports once a week").
+				WithDefault("@daily").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -0,0 +1,44 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"database spec",
+	tableTest(
+		"spec/database",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Database("DATABASE_URL", "DB").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Database("DATABASE_URL", "DB").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Database("DATABASE_URL", "DB").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
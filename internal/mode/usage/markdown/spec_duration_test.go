@@ -74,4 +74,30 @@ var _ = DescribeTable(
 				Required(ferrite.WithRegistry(reg))
 		},
 	),
+	Entry(
+		"with extended syntax and granularity",
+		"with-extended-syntax.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Duration("RETENTION_PERIOD", "how long to keep audit records").
+				WithExtendedUnits().
+				WithISO8601().
+				WithGranularity(24 * time.Hour).
+				WithMinimum(24 * time.Hour).
+				WithDefault(90 * 24 * time.Hour).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with extended units and partial days",
+		"with-extended-units.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Duration("LEASE_TTL", "how long a lease remains valid").
+				WithExtendedUnits().
+				WithDefault(36 * time.Hour).
+				WithMaximum(2 * 7 * 24 * time.Hour).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
 )
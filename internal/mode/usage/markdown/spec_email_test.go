@@ -0,0 +1,46 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"email address spec",
+	tableTest(
+		"spec/email",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				EmailAddress("ADMIN_EMAIL", "the address that receives alerts").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				EmailAddress("ADMIN_EMAIL", "the address that receives alerts").
+				WithDefault("Operations <ops@example.org>").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with allowed domains",
+		"with-allowed-domains.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				EmailAddress("ADMIN_EMAIL", "the address that receives alerts").
+				WithAllowedDomains("example.com", "example.net").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -86,4 +86,46 @@ var _ = DescribeTable(
 				Required(ferrite.WithRegistry(reg))
 		},
 	),
+	Entry(
+		"required with normalizers",
+		"with-normalizers.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Enum("LOG_LEVEL", "the minimum log level to record").
+				WithMember("debug", "show information for developers").
+				WithMember("info", "standard log messages").
+				WithMember("warn", "important, but don't need individual human review").
+				WithMember("error", "a healthy application shouldn't produce any errors").
+				WithMember("fatal", "the application cannot proceed").
+				WithNormalizers(ferrite.TrimSpace(), ferrite.FoldCase()).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with aliases",
+		"with-aliases.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Enum("APP_ENV", "the environment in which the application is running").
+				WithMember("production", "live customer-facing environment").
+				WithMember("staging", "pre-release testing environment").
+				WithMember("development", "local development environment").
+				WithAliases("production", "prod", "live").
+				WithAliases("development", "dev").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with case-insensitive matching",
+		"with-case-insensitive-matching.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Enum("APP_ENV", "the environment in which the application is running").
+				WithMember("production", "live customer-facing environment").
+				WithMember("staging", "pre-release testing environment").
+				WithMember("development", "local development environment").
+				WithCaseInsensitiveMatching().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
 )
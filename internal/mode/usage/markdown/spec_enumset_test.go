@@ -0,0 +1,94 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"enum set spec",
+	tableTest(
+		"spec/enumset",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				EnumSet("FEATURES", "the optional features to enable").
+				WithMember("search", "full-text search").
+				WithMember("billing", "subscription billing").
+				WithMember("exports", "data exports").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				EnumSet("FEATURES", "the optional features to enable").
+				WithMember("search", "full-text search").
+				WithMember("billing", "subscription billing").
+				WithMember("exports", "data exports").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				EnumSet("FEATURES", "the optional features to enable").
+				WithMember("search", "full-text search").
+				WithMember("billing", "subscription billing").
+				WithMember("exports", "data exports").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				EnumSet("FEATURES", "the optional features to enable").
+				WithMember("search", "full-text search").
+				WithMember("billing", "subscription billing").
+				WithMember("exports", "data exports").
+				WithDefault("search", "exports").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with selection limits and custom separator",
+		"with-limits.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				EnumSet("FEATURES", "the optional features to enable").
+				WithMember("search", "full-text search").
+				WithMember("billing", "subscription billing").
+				WithMember("exports", "data exports").
+				WithSeparator("|").
+				WithMinimumSelections(1).
+				WithMaximumSelections(2).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with minimum selections",
+		"with-minimum.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				EnumSet("FEATURES", "the optional features to enable").
+				WithMember("search", "full-text search").
+				WithMember("billing", "subscription billing").
+				WithMember("exports", "data exports").
+				WithMinimumSelections(1).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
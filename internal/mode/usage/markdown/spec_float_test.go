@@ -92,4 +92,16 @@ var _ = DescribeTable(
 				Required(ferrite.WithRegistry(reg))
 		},
 	),
+	Entry(
+		"with precision",
+		"with-precision.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Float[float32]("WEIGHT", "weighting for this node").
+				WithMinimum(0).
+				WithMaximum(10).
+				WithPrecision(2).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
 )
@@ -0,0 +1,55 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+type groupSpecUpstream struct {
+	Host string
+	Port string
+}
+
+func groupSpec() *ferrite.GroupBuilder[groupSpecUpstream] {
+	g := ferrite.Group[groupSpecUpstream]("the upstream server")
+	host := ferrite.Member(g, ferrite.String("UPSTREAM_HOST", "upstream host"))
+	port := ferrite.Member(g, ferrite.NetworkPort("UPSTREAM_PORT", "upstream port").WithDefault("8080"))
+
+	return g.WithConstructor(
+		func() (groupSpecUpstream, error) {
+			return groupSpecUpstream{host.Value(), port.Value()}, nil
+		},
+	)
+}
+
+var _ = DescribeTable(
+	"group spec",
+	tableTest(
+		"spec/group",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg ferrite.Registry) {
+			groupSpec().Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg ferrite.Registry) {
+			groupSpec().Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			groupSpec().Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
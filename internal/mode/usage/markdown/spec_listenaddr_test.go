@@ -0,0 +1,46 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"listen address spec",
+	tableTest(
+		"spec/listenaddr",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				ListenAddress("LISTEN_ADDR", "the address on which the server listens").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				ListenAddress("LISTEN_ADDR", "the address on which the server listens").
+				WithDefault(":8080").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with socket permissions",
+		"with-socket-permissions.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				ListenAddress("LISTEN_ADDR", "the address on which the server listens").
+				WithSocketPermissions(0o660).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
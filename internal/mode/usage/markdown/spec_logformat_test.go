@@ -0,0 +1,36 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"log format spec",
+	tableTest(
+		"spec/logformat",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				LogFormat("LOG_FORMAT", "the format of log messages").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				LogFormat("LOG_FORMAT", "the format of log messages").
+				WithDefault(ferrite.LogFormatText).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+)
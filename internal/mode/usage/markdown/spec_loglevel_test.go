@@ -0,0 +1,38 @@
+package markdown_test
+
+import (
+	"log/slog"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"log level spec",
+	tableTest(
+		"spec/loglevel",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				LogLevel("LOG_LEVEL", "the minimum level of log messages to emit").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				LogLevel("LOG_LEVEL", "the minimum level of log messages to emit").
+				WithDefault(slog.LevelInfo).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -0,0 +1,58 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"network address list spec",
+	tableTest(
+		"spec/networkaddrlist",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				NetworkAddressList("KAFKA_BROKERS", "the addresses of the Kafka brokers").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				NetworkAddressList("KAFKA_BROKERS", "the addresses of the Kafka brokers").
+				WithDefault("localhost:9092").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with default port and deduplication",
+		"with-default-port.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				NetworkAddressList("MEMCACHED_SERVERS", "the addresses of the memcached servers").
+				WithDefaultPort("11211").
+				WithDeduplication().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with minimum and maximum",
+		"with-min-max.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				NetworkAddressList("ETCD_PEERS", "the addresses of the etcd peers").
+				WithMinimumAddresses(3).
+				WithMaximumAddresses(7).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
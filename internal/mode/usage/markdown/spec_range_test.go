@@ -0,0 +1,61 @@
+package markdown_test
+
+import (
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"range spec",
+	tableTest(
+		"spec/range",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				RangeOf(
+					"PORT_RANGE",
+					"the range of ports to allocate from",
+					ferrite.NetworkPort("", ""),
+				).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				RangeOf(
+					"PORT_RANGE",
+					"the range of ports to allocate from",
+					ferrite.NetworkPort("", ""),
+				).
+				WithDefault(30000, 32767).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with element limits",
+		"with-element-limits.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				RangeOf(
+					"RETRY_BACKOFF",
+					"the minimum and maximum delay between retries",
+					ferrite.Duration("", "").
+						WithMinimum(10*time.Millisecond).
+						WithMaximum(1*time.Minute),
+				).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -0,0 +1,57 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"ratio spec",
+	tableTest(
+		"spec/ratio",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Ratio("SAMPLE_RATE", "the proportion of requests to trace").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Ratio("SAMPLE_RATE", "the proportion of requests to trace").
+				WithDefault(0.1).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with minimum and maximum values",
+		"with-minmax.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Ratio("SAMPLE_RATE", "the proportion of requests to trace").
+				WithMinimum(0.05).
+				WithMaximum(0.5).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"percent",
+		"percent.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Percent("SAMPLE_RATE", "the proportion of requests to trace").
+				WithPrecision(1).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -0,0 +1,75 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"regexp spec",
+	tableTest(
+		"spec/regexp",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Regexp("ROUTE_PATTERN", "pattern used to match request paths").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Regexp("ROUTE_PATTERN", "pattern used to match request paths").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Regexp("ROUTE_PATTERN", "pattern used to match request paths").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Regexp("ROUTE_PATTERN", "pattern used to match request paths").
+				WithDefault(`^/v1/`).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Regexp("ROUTE_PATTERN", "pattern used to match request paths").
+				WithDefault(`^/v1/`).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"anchored",
+		"with-anchoring.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Regexp("ROUTE_PATTERN", "pattern used to match request paths").
+				WithDefault(`/v[0-9]+/.*`).
+				WithAnchoring().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
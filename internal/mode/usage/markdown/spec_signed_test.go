@@ -92,4 +92,35 @@ var _ = DescribeTable(
 				Required(ferrite.WithRegistry(reg))
 		},
 	),
+	Entry(
+		"with multiple-of constraint",
+		"with-multiple-of.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Signed[int16]("WEIGHT", "weighting for this node").
+				WithMultipleOf(8).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with power-of-two constraint",
+		"with-power-of-two.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Signed[int16]("WEIGHT", "weighting for this node").
+				WithMinimum(64).
+				WithPowerOfTwo().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with base prefixes",
+		"with-base-prefixes.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Signed[int16]("WEIGHT", "weighting for this node").
+				WithBasePrefixes().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
 )
@@ -1,6 +1,8 @@
 package markdown_test
 
 import (
+	"regexp"
+
 	"github.com/dogmatiq/ferrite"
 	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
 	. "github.com/onsi/ginkgo/v2"
@@ -249,4 +251,64 @@ var _ = DescribeTable(
 				)
 		},
 	),
+	Entry(
+		"required with pattern",
+		"with-pattern.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				String("DEPLOYMENT_REGION", "the region in which the service is deployed").
+				WithPattern(regexp.MustCompile(`^[a-z]{2}-[a-z]+-[0-9]$`)).
+				WithExample("us-east-1", "a region in the United States").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with prefix and charset",
+		"with-prefix-and-charset.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				String("ACCESS_KEY_ID", "identifier of the access key").
+				WithPrefix("AKIA").
+				WithCharset(ferrite.AlphanumericCharset).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with hex charset and exact length",
+		"with-hex-charset.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				String("COMMIT_SHA", "the git commit hash of the build").
+				WithCharset(ferrite.HexCharset).
+				WithLength(40).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with no whitespace and constraint",
+		"with-no-whitespace.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				String("HOSTNAME", "the hostname of the service").
+				WithNoWhitespace().
+				WithConstraint(
+					"**MUST** be a lowercase hostname",
+					func(s string) bool {
+						return true
+					},
+				).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with normalizers",
+		"with-normalizers.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				String("CLUSTER_NAME", "the name of the cluster").
+				WithNormalizers(ferrite.TrimSpace(), ferrite.ToLower()).
+				WithNoWhitespace().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
 )
@@ -0,0 +1,57 @@
+package markdown_test
+
+import (
+	"crypto/tls"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"tls spec",
+	tableTest(
+		"spec/tls",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				TLS("TLS").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				TLS("TLS").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				TLS("TLS").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with defaults",
+		"with-defaults.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				TLS("TLS").
+				WithMinVersion(tls.VersionTLS13).
+				WithClientAuth(tls.RequireAndVerifyClientCert).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -0,0 +1,54 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"tri-state spec",
+	tableTest(
+		"spec/tristate",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				TriState("CACHE_ENABLED", "enable or disable the response cache").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				TriState("CACHE_ENABLED", "enable or disable the response cache").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				TriState("CACHE_ENABLED", "enable or disable the response cache").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				TriState("CACHE_ENABLED", "enable or disable the response cache").
+				WithDefault(ferrite.TriStateAuto).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
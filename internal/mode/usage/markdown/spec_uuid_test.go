@@ -0,0 +1,46 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"uuid spec",
+	tableTest(
+		"spec/uuid",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				UUID("TENANT_ID", "the ID of the tenant that owns this deployment").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				UUID("TENANT_ID", "the ID of the tenant that owns this deployment").
+				WithDefault("6ba7b810-9dad-11d1-80b4-00c04fd430c8").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with versions",
+		"with-versions.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				UUID("TENANT_ID", "the ID of the tenant that owns this deployment").
+				WithVersions(7).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -3,15 +3,16 @@ package validate
 import (
 	"fmt"
 
+	"github.com/dogmatiq/ferrite/internal/mode"
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
 
 // name renders a column containing the variable's name.
-func name(v variable.Any) string {
+func name(cfg mode.Config, v variable.Any) string {
 	s := v.Spec()
 
 	icon := " "
-	if attentionNeeded(v) != attentionNone {
+	if attentionNeeded(cfg, v) != attentionNone {
 		icon = iconAttention
 	}
 
@@ -18,6 +18,14 @@ func spec(v variable.Any) string {
 		Output: out,
 	})
 
+	if pd, ok := variable.ActiveProfileDefault(s); ok {
+		return fmt.Sprintf(
+			"[ %s ] = %s",
+			out,
+			render.Value(s, pd.Default),
+		)
+	}
+
 	if def, ok := s.Default(); ok {
 		return fmt.Sprintf(
 			"[ %s ] = %s",
@@ -26,6 +34,10 @@ func spec(v variable.Any) string {
 		)
 	}
 
+	if s.HasDerivedDefault() {
+		return fmt.Sprintf("[ %s ] = <derived>", out)
+	}
+
 	if s.IsRequired() {
 		return fmt.Sprintf("  %s  ", out)
 	}
@@ -73,6 +85,14 @@ func (r *schemaRenderer) VisitNumeric(s variable.Numeric) {
 			s.Type().Kind(),
 		)
 	}
+
+	if m, ok := s.MultipleOf(); ok {
+		fmt.Fprintf(r.Output, " ×%s", m.Quote())
+	}
+
+	if s.IsPowerOfTwo() {
+		r.Output.WriteString(" 2ⁿ")
+	}
 }
 
 func (r *schemaRenderer) VisitSet(s variable.Set) {
@@ -87,6 +107,27 @@ func (r *schemaRenderer) VisitSet(s variable.Set) {
 
 func (r *schemaRenderer) VisitString(s variable.String) {
 	fmt.Fprintf(r.Output, "<%s>", s.Type().Kind())
+
+	for _, rule := range s.Rules() {
+		r.Output.WriteByte(' ')
+		rule.AcceptVisitor(r)
+	}
+}
+
+func (r *schemaRenderer) VisitPatternRule(rule variable.PatternRule) {
+	fmt.Fprintf(r.Output, "/%s/", rule.Pattern)
+}
+
+func (r *schemaRenderer) VisitPrefixRule(rule variable.PrefixRule) {
+	fmt.Fprintf(r.Output, "%s*", variable.Literal{String: rule.Prefix}.Quote())
+}
+
+func (r *schemaRenderer) VisitCharsetRule(rule variable.CharsetRule) {
+	fmt.Fprintf(r.Output, "[%s]", rule.Charset.Class())
+}
+
+func (r *schemaRenderer) VisitNoWhitespaceRule(variable.NoWhitespaceRule) {
+	r.Output.WriteString("[^[:space:]]")
 }
 
 func (r *schemaRenderer) VisitOther(s variable.Other) {
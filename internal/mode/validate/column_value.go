@@ -3,13 +3,16 @@ package validate
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/dogmatiq/ferrite/internal/inflect"
+	"github.com/dogmatiq/ferrite/internal/mode"
 	"github.com/dogmatiq/ferrite/internal/mode/internal/render"
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
 
 // value renders a column describing the variable's value.
-func value(v variable.Any) string {
+func value(cfg mode.Config, v variable.Any) string {
 	s := v.Spec()
 
 	renderExplicit := func(icon string, lit variable.Literal, message string) string {
@@ -35,12 +38,55 @@ func value(v variable.Any) string {
 
 	switch v.Source() {
 	case variable.SourceNone:
+		if err, ok := v.Error().(variable.ExactlyOneError); ok {
+			return fmt.Sprintf(
+				"%s undefined, define exactly one of %s",
+				iconError,
+				inflect.AndList(err.Names()),
+			)
+		}
+		if err, ok := v.Error().(variable.ConditionalRequirementError); ok {
+			return fmt.Sprintf(
+				"%s undefined, required when %s",
+				iconError,
+				err.Condition(),
+			)
+		}
 		if s.IsRequired() {
 			return fmt.Sprintf("%s undefined", iconError)
 		}
 		return fmt.Sprintf("%s undefined", iconNeutral)
 
 	case variable.SourceDefault:
+		if err, ok := v.Error().(variable.ValueError); ok {
+			return fmt.Sprintf(
+				"%s using default value of %s, %s",
+				iconError,
+				render.Value(s, err.Literal()),
+				renderError(s, err),
+			)
+		}
+		if err, ok := variable.CheckCrossConstraints(v).(variable.CrossConstraintError); ok {
+			return fmt.Sprintf(
+				"%s using default value, %s",
+				iconError,
+				err.Constraint.Description(),
+			)
+		}
+		if pd, ok := variable.ActiveProfileDefault(s); ok {
+			return fmt.Sprintf(
+				"%s using default value for the %s profile",
+				iconOK,
+				pd.Profile.Value.String,
+			)
+		}
+		if p, ok := variable.ActiveProfile(s); ok {
+			return fmt.Sprintf(
+				"%s using default value, %s profile is active",
+				iconOK,
+				p.Value.String,
+			)
+		}
 		return fmt.Sprintf("%s using default value", iconOK)
 
 	default:
@@ -52,21 +98,64 @@ func value(v variable.Any) string {
 			)
 		}
 
+		if err, ok := variable.CheckCrossConstraints(v).(variable.CrossConstraintError); ok {
+			return renderExplicit(
+				iconError,
+				err.Literal(),
+				err.Constraint.Description(),
+			)
+		}
+
 		icon := iconOK
-		if s.IsDeprecated() {
+		if isExpired(cfg, s) {
+			icon = iconError
+		} else if s.IsDeprecated() {
 			icon = iconWarn
 		}
 
 		value := v.Value()
-		message := ""
+		var messages []string
 
 		if value.Verbatim() != value.Canonical() {
-			message = fmt.Sprintf(
+			messages = append(messages, fmt.Sprintf(
 				"equivalent to %s",
 				render.Value(s, value.Canonical()),
-			)
+			))
 		}
 
-		return renderExplicit(icon, value.Verbatim(), message)
+		if s.IsDeprecated() {
+			messages = append(messages, deprecationMessages(cfg, s)...)
+		}
+
+		if p, ok := variable.ActiveProfile(s); ok {
+			messages = append(messages, fmt.Sprintf("%s profile is active", p.Value.String))
+		}
+
+		return renderExplicit(icon, value.Verbatim(), strings.Join(messages, ", "))
+	}
+}
+
+// deprecationMessages returns messages describing the lifecycle of the
+// deprecated variable s.
+func deprecationMessages(cfg mode.Config, s variable.Spec) []string {
+	d := s.Deprecation()
+	var messages []string
+
+	if !d.Sunset.IsZero() {
+		if d.IsSunset(cfg.Now()) {
+			messages = append(messages, fmt.Sprintf("no longer supported since %s", d.Sunset.Format(time.DateOnly)))
+		} else {
+			messages = append(messages, fmt.Sprintf("supported until %s", d.Sunset.Format(time.DateOnly)))
+		}
 	}
+
+	if d.RemovedIn != "" {
+		if d.IsRemoved(cfg.AppVersion) {
+			messages = append(messages, fmt.Sprintf("removed in %s", d.RemovedIn))
+		} else {
+			messages = append(messages, fmt.Sprintf("to be removed in %s", d.RemovedIn))
+		}
+	}
+
+	return messages
 }
@@ -23,6 +23,10 @@ type errorRenderer struct {
 	Error  variable.ValueError
 }
 
+func (r *errorRenderer) VisitMutuallyExclusiveError(err variable.MutuallyExclusiveError) {
+	r.Output.WriteString(err.Error())
+}
+
 func (r *errorRenderer) VisitGenericError(error) {
 	r.Schema.AcceptVisitor(r)
 }
@@ -62,6 +66,18 @@ func (r *errorRenderer) VisitMaxError(err variable.MaxError) {
 	r.Output.WriteString(err.Error())
 }
 
+func (r *errorRenderer) VisitNonFiniteError(err variable.NonFiniteError) {
+	r.Output.WriteString(err.Error())
+}
+
+func (r *errorRenderer) VisitMultipleOfError(err variable.MultipleOfError) {
+	r.Output.WriteString(err.Error())
+}
+
+func (r *errorRenderer) VisitPowerOfTwoError(err variable.PowerOfTwoError) {
+	r.Output.WriteString(err.Error())
+}
+
 func (r *errorRenderer) VisitSet(variable.Set) {
 	r.Output.WriteString(r.Error.Unwrap().Error())
 }
@@ -82,6 +98,22 @@ func (r *errorRenderer) VisitMaxLengthError(err variable.MaxLengthError) {
 	r.Output.WriteString(err.Error())
 }
 
+func (r *errorRenderer) VisitPatternError(err variable.PatternError) {
+	r.Output.WriteString(err.Error())
+}
+
+func (r *errorRenderer) VisitPrefixError(err variable.PrefixError) {
+	r.Output.WriteString(err.Error())
+}
+
+func (r *errorRenderer) VisitCharsetError(err variable.CharsetError) {
+	r.Output.WriteString(err.Error())
+}
+
+func (r *errorRenderer) VisitWhitespaceError(err variable.WhitespaceError) {
+	r.Output.WriteString(err.Error())
+}
+
 func (r *errorRenderer) VisitOther(variable.Other) {
 	r.Output.WriteString(r.Error.Unwrap().Error())
 }
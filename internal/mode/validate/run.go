@@ -20,13 +20,13 @@ func Run(cfg mode.Config) {
 	t := table{}
 	for _, v := range cfg.Registries.Variables() {
 		t.AddRow(
-			name(v),
+			name(cfg, v),
 			description(v),
 			spec(v),
-			value(v),
+			value(cfg, v),
 		)
 
-		switch attentionNeeded(v) {
+		switch attentionNeeded(cfg, v) {
 		case attentionWarning:
 			show = true
 		case attentionError:
@@ -71,7 +71,7 @@ const (
 )
 
 // attentionNeeded returns true if v needs attention from the user.
-func attentionNeeded(v variable.Any) attentionLevel {
+func attentionNeeded(cfg mode.Config, v variable.Any) attentionLevel {
 	s := v.Spec()
 
 	if err := v.Error(); err != nil {
@@ -84,9 +84,22 @@ func attentionNeeded(v variable.Any) attentionLevel {
 		}
 	}
 
+	if err := variable.CheckCrossConstraints(v); err != nil {
+		return attentionError
+	}
+
 	if s.IsDeprecated() && v.Source() == variable.SourceEnvironment {
+		if isExpired(cfg, s) {
+			return attentionError
+		}
 		return attentionWarning
 	}
 
 	return attentionNone
 }
+
+// isExpired returns true if s is a deprecated variable that is no longer
+// supported.
+func isExpired(cfg mode.Config, s variable.Spec) bool {
+	return s.IsDeprecated() && s.Deprecation().IsExpired(cfg.AppVersion, cfg.Now())
+}
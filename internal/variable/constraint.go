@@ -62,3 +62,69 @@ const (
 	// against the default value.
 	ConstraintContextDefault
 )
+
+// CrossConstraint is a constraint that involves the values of several
+// variables.
+//
+// It is checked after each of the variables has been resolved individually.
+type CrossConstraint struct {
+	desc  string
+	vars  []Any
+	check func() bool
+}
+
+// AddCrossConstraint adds a constraint involving each of the given variables.
+//
+// fn is only called if all of the variables have valid values. If fn returns
+// false the value of each of the variables is considered invalid.
+func AddCrossConstraint(desc string, fn func() bool, vars ...Any) {
+	c := &CrossConstraint{desc, vars, fn}
+
+	for _, v := range vars {
+		v.Spec().addCrossConstraint(c)
+	}
+}
+
+// Description returns a description of the constraint.
+func (c *CrossConstraint) Description() string {
+	return c.desc
+}
+
+// Variables returns the variables that participate in the constraint.
+func (c *CrossConstraint) Variables() []Any {
+	return c.vars
+}
+
+// isSatisfied returns true if the constraint is satisfied, or if it can not be
+// checked because some of the variables do not have valid values.
+func (c *CrossConstraint) isSatisfied() bool {
+	for _, v := range c.vars {
+		if v.Availability() != AvailabilityOK || v.Error() != nil {
+			return true
+		}
+	}
+
+	return c.check()
+}
+
+// CheckCrossConstraints returns an error if the value of v does not satisfy
+// the cross-variable constraints that it participates in.
+//
+// It returns nil if v does not have a valid value of its own.
+func CheckCrossConstraints(v Any) Error {
+	if v.Availability() != AvailabilityOK || v.Error() != nil {
+		return nil
+	}
+
+	for _, c := range v.Spec().CrossConstraints() {
+		if !c.isSatisfied() {
+			return CrossConstraintError{
+				name:       v.Spec().Name(),
+				literal:    v.Value().Verbatim(),
+				Constraint: c,
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package variable
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Deprecation describes the lifecycle of a deprecated variable.
+type Deprecation struct {
+	// Since is the version of the application in which the variable was
+	// deprecated. It is empty if the version is unknown.
+	Since string
+
+	// RemovedIn is the version of the application in which the variable is
+	// no longer supported. It is empty if the version is unknown.
+	RemovedIn string
+
+	// Sunset is the time after which the variable is no longer supported. It
+	// is the zero-value if there is no such time.
+	Sunset time.Time
+}
+
+// IsExpired returns true if the variable is no longer supported by the given
+// version of the application at the given time.
+//
+// If version is empty, only the sunset time is considered.
+func (d Deprecation) IsExpired(version string, now time.Time) bool {
+	return d.IsSunset(now) || d.IsRemoved(version)
+}
+
+// IsSunset returns true if the variable's sunset time has passed.
+func (d Deprecation) IsSunset(now time.Time) bool {
+	return !d.Sunset.IsZero() && now.After(d.Sunset)
+}
+
+// IsRemoved returns true if the variable has been removed as of the given
+// version of the application.
+//
+// It returns false if version is empty.
+func (d Deprecation) IsRemoved(version string) bool {
+	if d.RemovedIn == "" || version == "" {
+		return false
+	}
+
+	c, err := CompareVersions(version, d.RemovedIn)
+	return err == nil && c >= 0
+}
+
+// CheckVersion returns an error if v is not a valid version number.
+//
+// Versions are of the form "[v]MAJOR[.MINOR[.PATCH]][-PRERELEASE][+BUILD]", as
+// per semantic versioning.
+func CheckVersion(v string) error {
+	_, err := parseVersion(v)
+	return err
+}
+
+// CompareVersions compares two version numbers.
+//
+// It returns a negative number if a < b, a positive number if a > b, or zero if
+// the versions are equal.
+func CompareVersions(a, b string) (int, error) {
+	x, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+
+	y, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range x.numbers {
+		if c := x.numbers[i] - y.numbers[i]; c != 0 {
+			return c, nil
+		}
+	}
+
+	// A pre-release version has a lower precedence than the associated normal
+	// version.
+	switch {
+	case x.preRelease == y.preRelease:
+		return 0, nil
+	case x.preRelease == "":
+		return 1, nil
+	case y.preRelease == "":
+		return -1, nil
+	default:
+		return comparePreRelease(x.preRelease, y.preRelease), nil
+	}
+}
+
+// comparePreRelease compares two pre-release versions according to the
+// precedence rules of semantic versioning.
+//
+// Each dot-separated identifier is compared in turn. Numeric identifiers are
+// compared numerically and have a lower precedence than alphanumeric
+// identifiers, which are compared lexically. If all of the identifiers are
+// equal, the version with fewer identifiers has a lower precedence.
+func comparePreRelease(a, b string) int {
+	x := strings.Split(a, ".")
+	y := strings.Split(b, ".")
+
+	for i := 0; i < len(x) && i < len(y); i++ {
+		xNumeric := isNumericIdentifier(x[i])
+		yNumeric := isNumericIdentifier(y[i])
+
+		var c int
+		switch {
+		case xNumeric && yNumeric:
+			c = compareNumericIdentifiers(x[i], y[i])
+		case xNumeric:
+			c = -1
+		case yNumeric:
+			c = 1
+		default:
+			c = strings.Compare(x[i], y[i])
+		}
+
+		if c != 0 {
+			return c
+		}
+	}
+
+	return len(x) - len(y)
+}
+
+// isNumericIdentifier returns true if the pre-release identifier s consists
+// only of digits.
+func isNumericIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compareNumericIdentifiers compares two numeric pre-release identifiers
+// without converting them to integers, such that arbitrarily large values are
+// compared correctly.
+func compareNumericIdentifiers(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+
+	if c := len(a) - len(b); c != 0 {
+		return c
+	}
+
+	return strings.Compare(a, b)
+}
+
+// version is a parsed version number.
+type version struct {
+	numbers    [3]int
+	preRelease string
+}
+
+func parseVersion(v string) (version, error) {
+	var result version
+
+	s := strings.TrimPrefix(v, "v")
+
+	if i := strings.IndexByte(s, '+'); i != -1 {
+		s = s[:i]
+	}
+
+	if i := strings.IndexByte(s, '-'); i != -1 {
+		result.preRelease = s[i+1:]
+		s = s[:i]
+
+		if result.preRelease == "" {
+			return version{}, fmt.Errorf("invalid version (%s): pre-release identifier must not be empty", v)
+		}
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > len(result.numbers) {
+		return version{}, fmt.Errorf("invalid version (%s): too many components", v)
+	}
+
+	for i, p := range parts {
+		n, err := strconv.ParseUint(p, 10, 31)
+		if err != nil {
+			return version{}, fmt.Errorf("invalid version (%s): components must be non-negative integers", v)
+		}
+		result.numbers[i] = int(n)
+	}
+
+	return result, nil
+}
@@ -1,6 +1,10 @@
 package variable
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/dogmatiq/ferrite/internal/inflect"
+)
 
 // Error is an error that indicates a problem parsing or validating an
 // environment variable.
@@ -73,3 +77,126 @@ func explainLengthError(s LengthLimited) string {
 
 	return fmt.Sprintf("expected %s between %d and %d bytes", s.LengthDescription(), min, max)
 }
+
+// MutuallyExclusiveError indicates that a variable is defined at the same time
+// as one or more of the variables that it is mutually exclusive with.
+type MutuallyExclusiveError struct {
+	Conflicts []Spec
+}
+
+func (e MutuallyExclusiveError) Error() string {
+	names := make([]string, len(e.Conflicts))
+	for i, s := range e.Conflicts {
+		names[i] = s.Name()
+	}
+
+	if len(names) == 1 {
+		return fmt.Sprintf(
+			"can not be used in combination with %s, define one or the other",
+			names[0],
+		)
+	}
+
+	return fmt.Sprintf(
+		"can not be used in combination with %s, define only one of them",
+		inflect.AndList(names),
+	)
+}
+
+// ExactlyOneError is an Error that indicates that none of a group of mutually
+// exclusive variables are defined, when exactly one of them must be.
+type ExactlyOneError struct {
+	name  string
+	names []string
+}
+
+// Name returns the name of the environment variable.
+func (e ExactlyOneError) Name() string {
+	return e.name
+}
+
+// Names returns the names of all of the variables in the group, one of which
+// must be defined.
+func (e ExactlyOneError) Names() []string {
+	return e.names
+}
+
+func (e ExactlyOneError) Error() string {
+	return fmt.Sprintf(
+		"%s is undefined, define exactly one of %s",
+		e.name,
+		inflect.AndList(e.names),
+	)
+}
+
+// CrossConstraintError is an Error that indicates that a variable's value
+// does not satisfy a constraint involving several variables.
+type CrossConstraintError struct {
+	name    string
+	literal Literal
+
+	// Constraint is the constraint that was not satisfied.
+	Constraint *CrossConstraint
+}
+
+// Name returns the name of the environment variable.
+func (e CrossConstraintError) Name() string {
+	return e.name
+}
+
+// Literal returns the value of the variable.
+func (e CrossConstraintError) Literal() Literal {
+	return e.literal
+}
+
+func (e CrossConstraintError) Error() string {
+	return fmt.Sprintf(
+		"value of %s (%s) is invalid: %s",
+		e.name,
+		e.literal.Quote(),
+		e.Constraint.Description(),
+	)
+}
+
+// ConditionalRequirementError is an Error that indicates that a variable is
+// undefined when it is required due to the value of another variable.
+type ConditionalRequirementError struct {
+	spec Spec
+}
+
+// Name returns the name of the environment variable.
+func (e ConditionalRequirementError) Name() string {
+	return e.spec.Name()
+}
+
+// Condition returns a human-readable description of the condition under which
+// the variable is required.
+func (e ConditionalRequirementError) Condition() string {
+	var conds []string
+
+	for _, rel := range Relationships[DependsOn](e.spec) {
+		if !rel.Required {
+			continue
+		}
+
+		name := rel.DependsOn.Name()
+
+		if len(rel.Values) != 0 {
+			conds = append(conds, fmt.Sprintf("%s is %s", name, rel.Values[0].Quote()))
+		} else if z := rel.DependsOn.Zero(); z.String == "" {
+			conds = append(conds, fmt.Sprintf("%s is defined", name))
+		} else {
+			conds = append(conds, fmt.Sprintf("%s is not %s", name, z.Quote()))
+		}
+	}
+
+	return inflect.OrList(conds)
+}
+
+func (e ConditionalRequirementError) Error() string {
+	return fmt.Sprintf(
+		"%s is undefined but is required when %s",
+		e.spec.Name(),
+		e.Condition(),
+	)
+}
@@ -0,0 +1,84 @@
+package variable
+
+// Profile is a named configuration profile, such as "development" or
+// "production", that is selected by the value of another variable.
+type Profile struct {
+	// Selector is the variable that selects the profile.
+	Selector Spec
+
+	// Value is the value that the selector variable must have in order for the
+	// profile to be active. It is also used as the profile's name.
+	Value Literal
+
+	isActive func() bool
+}
+
+// NewProfile returns a profile that is selected by the given variable.
+//
+// isActive is a function that returns true if the selector variable currently
+// has the value v.
+func NewProfile(selector Spec, v Literal, isActive func() bool) *Profile {
+	return &Profile{
+		Selector: selector,
+		Value:    v,
+		isActive: isActive,
+	}
+}
+
+// IsActive returns true if the profile is currently selected.
+func (p *Profile) IsActive() bool {
+	return p.isActive()
+}
+
+// typedProfileDefault is a default value of type T that applies only when a
+// specific profile is active.
+type typedProfileDefault[T any] struct {
+	profile *Profile
+	value   valueOf[T]
+}
+
+// ProfileDefault is a relationship type that indicates that a variable has a
+// default value that applies only when a specific profile is active.
+//
+// The object of the relationship is the profile's selector variable.
+type ProfileDefault struct {
+	Subject Spec
+	Profile *Profile
+
+	// Default is the default value that is used when the profile is active.
+	Default Literal
+}
+
+func (r ProfileDefault) subject() Spec {
+	return r.Subject
+}
+
+func (r ProfileDefault) object() Spec {
+	return r.Profile.Selector
+}
+
+// ActiveProfileDefault returns the profile-specific default value of s that
+// applies to the currently active profile, if any.
+func ActiveProfileDefault(s Spec) (ProfileDefault, bool) {
+	for _, rel := range Relationships[ProfileDefault](s) {
+		if rel.Profile.IsActive() {
+			return rel, true
+		}
+	}
+
+	return ProfileDefault{}, false
+}
+
+// ActiveProfile returns the profile that is currently selected by s, if any.
+//
+// Only those profiles that are used by at least one profile-specific default
+// value are considered.
+func ActiveProfile(s Spec) (*Profile, bool) {
+	for _, rel := range InverseRelationships[ProfileDefault](s) {
+		if rel.Profile.IsActive() {
+			return rel.Profile, true
+		}
+	}
+
+	return nil, false
+}
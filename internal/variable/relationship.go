@@ -1,7 +1,5 @@
 package variable
 
-import "github.com/dogmatiq/ferrite/internal/maybe"
-
 // Relationship represents a relationship between two variables.
 type Relationship interface {
 	subject() Spec
@@ -81,9 +79,31 @@ func (r RefersTo) object() Spec {
 type DependsOn struct {
 	Subject, DependsOn Spec
 
-	// Value is the value that the dependency must have in order for the subject
-	// to be used. If it is absent the dependency must be any "truthy" value.
-	Value maybe.Value[Literal]
+	// Values is the set of values that the dependency must have one of in
+	// order for the subject to be used. If it is empty the dependency must be
+	// any "truthy" value.
+	Values []Literal
+
+	// Description is a human-readable description of a custom condition that
+	// the dependency must satisfy, such as "is greater than 10". If it is
+	// non-empty it is used instead of Values.
+	Description string
+
+	// Negated is true if the subject is only used when the dependency does NOT
+	// satisfy the condition described above.
+	Negated bool
+
+	// Group, if non-zero, identifies a set of alternative conditions. The
+	// subject is used if all of the conditions with any one Alternative value
+	// within the group are satisfied.
+	//
+	// Conditions that are not part of the same group must all be satisfied.
+	Group, Alternative int
+
+	// Required is true if the subject is required when the dependency has the
+	// value described above. Otherwise, the subject is ignored when the
+	// dependency does not have that value.
+	Required bool
 }
 
 func (r DependsOn) subject() Spec {
@@ -93,3 +113,54 @@ func (r DependsOn) subject() Spec {
 func (r DependsOn) object() Spec {
 	return r.DependsOn
 }
+
+// DerivesDefaultFrom is a relationship type that indicates that a variable's
+// default value is derived from the value of another variable.
+type DerivesDefaultFrom struct {
+	Subject, DerivesDefaultFrom Spec
+}
+
+func (r DerivesDefaultFrom) subject() Spec {
+	return r.Subject
+}
+
+func (r DerivesDefaultFrom) object() Spec {
+	return r.DerivesDefaultFrom
+}
+
+// MutuallyExclusive is a relationship type that indicates that a variable must
+// not be defined at the same time as another variable.
+type MutuallyExclusive struct {
+	Subject, MutuallyExclusiveWith Spec
+
+	// ExactlyOne is true if one of the variables in the relationship must be
+	// defined. Otherwise, both variables may be left undefined.
+	ExactlyOne bool
+}
+
+func (r MutuallyExclusive) subject() Spec {
+	return r.Subject
+}
+
+func (r MutuallyExclusive) object() Spec {
+	return r.MutuallyExclusiveWith
+}
+
+// Exclusions returns the mutually exclusive relationships that involve s.
+//
+// Mutual exclusivity is symmetrical, so the relationships are oriented such
+// that s is always the subject.
+func Exclusions(s Spec) []MutuallyExclusive {
+	var result []MutuallyExclusive
+
+	for _, rel := range s.Relationships() {
+		if rel, ok := rel.(MutuallyExclusive); ok {
+			if rel.Subject != s {
+				rel.Subject, rel.MutuallyExclusiveWith = rel.MutuallyExclusiveWith, rel.Subject
+			}
+			result = append(result, rel)
+		}
+	}
+
+	return result
+}
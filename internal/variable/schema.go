@@ -62,6 +62,9 @@ type SchemaErrorVisitor interface {
 	// Numeric errors ...
 	VisitMinError(MinError)
 	VisitMaxError(MaxError)
+	VisitNonFiniteError(NonFiniteError)
+	VisitMultipleOfError(MultipleOfError)
+	VisitPowerOfTwoError(PowerOfTwoError)
 
 	// Set errors...
 	VisitSetMembershipError(SetMembershipError)
@@ -69,6 +72,10 @@ type SchemaErrorVisitor interface {
 	// String errors ...
 	VisitMinLengthError(MinLengthError)
 	VisitMaxLengthError(MaxLengthError)
+	VisitPatternError(PatternError)
+	VisitPrefixError(PrefixError)
+	VisitCharsetError(CharsetError)
+	VisitWhitespaceError(WhitespaceError)
 }
 
 // TypedSchema describes the valid values of an environment varible value
@@ -3,6 +3,7 @@ package variable
 import (
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
@@ -33,12 +34,27 @@ type Numeric interface {
 
 	// Bits is the number of bits used to store the number.
 	Bits() int
+
+	// MultipleOf returns the number that the value must be a multiple of, as
+	// a literal.
+	MultipleOf() (Literal, bool)
+
+	// IsPowerOfTwo returns true if the value must be a power of two.
+	IsPowerOfTwo() bool
 }
 
 // TypedNumeric is a numeric value depicted by type T.
 type TypedNumeric[T constraints.Integer | constraints.Float] struct {
 	Marshaler            Marshaler[T]
 	NativeMin, NativeMax maybe.Value[T]
+
+	// NativeMultipleOf is the number that the value must be a multiple of. It
+	// is only meaningful for integer types.
+	NativeMultipleOf maybe.Value[T]
+
+	// PowerOfTwo indicates that the value must be a power of two. It is only
+	// meaningful for integer types.
+	PowerOfTwo bool
 }
 
 // Min returns the minimum permitted value as a literal.
@@ -90,6 +106,17 @@ func (s TypedNumeric[T]) Bits() int {
 	return int(unsafe.Sizeof(T(0))) * 8
 }
 
+// MultipleOf returns the number that the value must be a multiple of, as a
+// literal.
+func (s TypedNumeric[T]) MultipleOf() (Literal, bool) {
+	return mustMarshal(s.Marshaler, s.NativeMultipleOf).Get()
+}
+
+// IsPowerOfTwo returns true if the value must be a power of two.
+func (s TypedNumeric[T]) IsPowerOfTwo() bool {
+	return s.PowerOfTwo
+}
+
 // Type returns the type of the native value.
 func (s TypedNumeric[T]) Type() reflect.Type {
 	return reflectx.TypeOf[T]()
@@ -99,14 +126,30 @@ func (s TypedNumeric[T]) Type() reflect.Type {
 //
 // It returns an error if schema is invalid.
 func (s TypedNumeric[T]) Finalize() error {
-	if _, err := marshal(s.Marshaler, s.NativeMin); err != nil {
+	minLit, err := marshal(s.Marshaler, s.NativeMin)
+	if err != nil {
 		return fmt.Errorf("minimum value: %w", err)
 	}
 
-	if _, err := marshal(s.Marshaler, s.NativeMax); err != nil {
+	maxLit, err := marshal(s.Marshaler, s.NativeMax)
+	if err != nil {
 		return fmt.Errorf("maximum value: %w", err)
 	}
 
+	if min, ok := s.NativeMin.Get(); ok {
+		if max, ok := s.NativeMax.Get(); ok && min > max {
+			return fmt.Errorf(
+				"minimum value (%s) must not be greater than the maximum value (%s)",
+				minLit.MustGet().String,
+				maxLit.MustGet().String,
+			)
+		}
+	}
+
+	if m, ok := s.NativeMultipleOf.Get(); ok && m <= 0 {
+		return errors.New("multiple-of value must be positive")
+	}
+
 	return nil
 }
 
@@ -174,10 +217,10 @@ func (s TypedNumeric[T]) Examples(conservative bool) []TypedExample[T] {
 		examples = append(
 			examples,
 			TypedExample[T]{
-				Native: lerp(0.45),
+				Native: s.align(lerp(0.45)),
 			},
 			TypedExample[T]{
-				Native: lerp(0.60),
+				Native: s.align(lerp(0.60)),
 			},
 		)
 	}
@@ -185,8 +228,35 @@ func (s TypedNumeric[T]) Examples(conservative bool) []TypedExample[T] {
 	return examples
 }
 
+// align returns the value closest to v (but not greater than v) that
+// satisfies the schema's multiple-of and power-of-two requirements, if any.
+//
+// The result may still be invalid, for example if it falls outside the
+// permitted range.
+func (s TypedNumeric[T]) align(v T) T {
+	if s.PowerOfTwo && v >= 1 {
+		p := T(1)
+		for p <= v/2 {
+			p *= 2
+		}
+		v = p
+	}
+
+	if m, ok := s.NativeMultipleOf.Get(); ok {
+		v = v / m * m
+	}
+
+	return v
+}
+
 // validate returns an error if v is invalid.
 func (s TypedNumeric[T]) validate(v T) error {
+	// The non-finite values NaN, +Inf and -Inf are never permitted. They can
+	// only occur for floating-point types.
+	if f := float64(v); math.IsNaN(f) || math.IsInf(f, 0) {
+		return NonFiniteError{s}
+	}
+
 	if min, ok := s.NativeMin.Get(); ok && v < min {
 		return MinError{s}
 	}
@@ -195,6 +265,16 @@ func (s TypedNumeric[T]) validate(v T) error {
 		return MaxError{s}
 	}
 
+	if m, ok := s.NativeMultipleOf.Get(); ok && v/m*m != v {
+		return MultipleOfError{s}
+	}
+
+	if s.PowerOfTwo {
+		if u := uint64(v); v <= 0 || u&(u-1) != 0 {
+			return PowerOfTwoError{s}
+		}
+	}
+
 	return nil
 }
 
@@ -242,6 +322,72 @@ func (e MaxError) Error() string {
 	return fmt.Sprintf("too high, %s", explainRangeError(e.Numeric))
 }
 
+// NonFiniteError indicates that a numeric value was NaN, +Inf or -Inf when
+// only finite values are permitted.
+type NonFiniteError struct {
+	Numeric Numeric
+}
+
+var _ SchemaError = NonFiniteError{}
+
+// Schema returns the schema that was violated.
+func (e NonFiniteError) Schema() Schema {
+	return e.Numeric
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e NonFiniteError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitNonFiniteError(e)
+}
+
+func (e NonFiniteError) Error() string {
+	return "expected a finite number"
+}
+
+// MultipleOfError indicates that a numeric value was not a multiple of the
+// required number.
+type MultipleOfError struct {
+	Numeric Numeric
+}
+
+var _ SchemaError = MultipleOfError{}
+
+// Schema returns the schema that was violated.
+func (e MultipleOfError) Schema() Schema {
+	return e.Numeric
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e MultipleOfError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitMultipleOfError(e)
+}
+
+func (e MultipleOfError) Error() string {
+	m, _ := e.Numeric.MultipleOf()
+	return fmt.Sprintf("expected a multiple of %s", m.Quote())
+}
+
+// PowerOfTwoError indicates that a numeric value was not a power of two.
+type PowerOfTwoError struct {
+	Numeric Numeric
+}
+
+var _ SchemaError = PowerOfTwoError{}
+
+// Schema returns the schema that was violated.
+func (e PowerOfTwoError) Schema() Schema {
+	return e.Numeric
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e PowerOfTwoError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitPowerOfTwoError(e)
+}
+
+func (e PowerOfTwoError) Error() string {
+	return "expected a power of two"
+}
+
 func explainRangeError(s Numeric) string {
 	min, hasMin := s.Min()
 	max, hasMax := s.Max()
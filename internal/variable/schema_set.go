@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 
+	"github.com/dogmatiq/ferrite/internal/editdistance"
 	"github.com/dogmatiq/ferrite/internal/reflectx"
 )
 
@@ -14,12 +16,41 @@ type Set interface {
 
 	// Literals returns the members of the set as literals.
 	Literals() []Literal
+
+	// Aliases returns the alternative literals that are accepted in place of
+	// lit, which must be one of the literals returned by Literals().
+	Aliases(lit Literal) []Literal
+
+	// IsCaseSensitive returns true if literals must match the case of the
+	// set's members exactly.
+	IsCaseSensitive() bool
+}
+
+// ExtendedSet is an interface for a [Set] that also accepts values that are
+// derived from its members, such as a member followed by a numeric offset.
+type ExtendedSet interface {
+	Set
+
+	// ExtensionDescription returns a human-readable description of the values
+	// that are accepted in addition to the set's members.
+	//
+	// It must complete the sentence "The value must be one of the members, or
+	// <desc>."
+	ExtensionDescription() string
 }
 
 // TypedSet is a Set containing values of type T.
 type TypedSet[T any] struct {
 	Members   []SetMember[T]
 	ToLiteral func(T) Literal
+
+	// MemberAliases is a list of alternative literal representations of the
+	// set's members.
+	MemberAliases []SetAlias[T]
+
+	// CaseInsensitive indicates that literals are matched against the set's
+	// members (and their aliases) without regard to case.
+	CaseInsensitive bool
 }
 
 // SetMember is a member of a TypedSet.
@@ -28,6 +59,13 @@ type SetMember[T any] struct {
 	Description string
 }
 
+// SetAlias is an alternative literal representation of a member of a
+// TypedSet.
+type SetAlias[T any] struct {
+	Value   T
+	Literal Literal
+}
+
 // Literals returns the members of the set as literals.
 func (s TypedSet[T]) Literals() []Literal {
 	literals := make([]Literal, len(s.Members))
@@ -37,6 +75,24 @@ func (s TypedSet[T]) Literals() []Literal {
 	return literals
 }
 
+// Aliases returns the alternative literals that are accepted in place of lit,
+// which must be one of the literals returned by Literals().
+func (s TypedSet[T]) Aliases(lit Literal) []Literal {
+	var aliases []Literal
+	for _, a := range s.MemberAliases {
+		if s.ToLiteral(a.Value) == lit {
+			aliases = append(aliases, a.Literal)
+		}
+	}
+	return aliases
+}
+
+// IsCaseSensitive returns true if literals must match the case of the set's
+// members exactly.
+func (s TypedSet[T]) IsCaseSensitive() bool {
+	return !s.CaseInsensitive
+}
+
 // Type returns the type of the native value.
 func (s TypedSet[T]) Type() reflect.Type {
 	return reflectx.TypeOf[T]()
@@ -59,21 +115,69 @@ func (s TypedSet[T]) Finalize() error {
 			return errors.New("literals can not be an empty string")
 		}
 
-		for _, v := range uniq {
-			if v == lit {
-				return fmt.Errorf(
-					"literals must be unique but multiple values are represented as %q",
-					lit.String,
-				)
-			}
+		if err := s.checkUnique(uniq, lit); err != nil {
+			return err
 		}
 
 		uniq = append(uniq, lit)
 	}
 
+	members := uniq
+
+	for _, a := range s.MemberAliases {
+		if a.Literal.String == "" {
+			return errors.New("aliases can not be an empty string")
+		}
+
+		lit := s.ToLiteral(a.Value)
+		isMember := false
+		for _, m := range members {
+			if m == lit {
+				isMember = true
+				break
+			}
+		}
+
+		if !isMember {
+			return fmt.Errorf(
+				"alias %q refers to %q, which is not a member of the set",
+				a.Literal.String,
+				lit.String,
+			)
+		}
+
+		if err := s.checkUnique(uniq, a.Literal); err != nil {
+			return err
+		}
+
+		uniq = append(uniq, a.Literal)
+	}
+
 	return nil
 }
 
+// checkUnique returns an error if lit matches any of the literals in uniq.
+func (s TypedSet[T]) checkUnique(uniq []Literal, lit Literal) error {
+	for _, v := range uniq {
+		if s.matches(v, lit) {
+			return fmt.Errorf(
+				"literals must be unique but multiple values are represented as %q",
+				lit.String,
+			)
+		}
+	}
+	return nil
+}
+
+// matches returns true if the literal lit is equivalent to the member literal
+// m, according to the set's case sensitivity.
+func (s TypedSet[T]) matches(m, lit Literal) bool {
+	if s.CaseInsensitive {
+		return strings.EqualFold(m.String, lit.String)
+	}
+	return m == lit
+}
+
 // AcceptVisitor passes s to the appropriate method of v.
 func (s TypedSet[T]) AcceptVisitor(v SchemaVisitor) {
 	v.VisitSet(s)
@@ -89,19 +193,27 @@ func (s TypedSet[T]) Marshal(v T) (Literal, error) {
 		}
 	}
 
-	return Literal{}, SetMembershipError{s}
+	return Literal{}, SetMembershipError{Set: s}
 }
 
 // Unmarshal converts a literal value to it's native representation.
+//
+// v may be the literal representation of a member, or one of its aliases.
 func (s TypedSet[T]) Unmarshal(v Literal) (T, error) {
 	for _, m := range s.Members {
-		if v == s.ToLiteral(m.Value) {
+		if s.matches(s.ToLiteral(m.Value), v) {
 			return m.Value, nil
 		}
 	}
 
+	for _, a := range s.MemberAliases {
+		if s.matches(a.Literal, v) {
+			return a.Value, nil
+		}
+	}
+
 	var zero T
-	return zero, SetMembershipError{s}
+	return zero, SetMembershipError{Set: s, Literal: v}
 }
 
 // Examples returns a (possibly empty) set of examples of valid values.
@@ -123,6 +235,10 @@ func (s TypedSet[T]) Examples(bool) []TypedExample[T] {
 // member of a specific set.
 type SetMembershipError struct {
 	Set Set
+
+	// Literal is the value that is not a member of the set, if known. It is
+	// used to suggest a similar member.
+	Literal Literal
 }
 
 var _ SchemaError = SetMembershipError{}
@@ -138,6 +254,10 @@ func (e SetMembershipError) AcceptVisitor(v SchemaErrorVisitor) {
 }
 
 func (e SetMembershipError) Error() string {
+	if s, ok := e.Suggestion(); ok {
+		return fmt.Sprintf("did you mean %s?", s.Quote())
+	}
+
 	members := e.Set.Literals()
 
 	switch n := len(members); n {
@@ -172,3 +292,41 @@ func (e SetMembershipError) Error() string {
 		)
 	}
 }
+
+// Suggestion returns the member literal or alias that is most similar to the
+// invalid literal, if it is similar enough that the invalid literal is likely
+// to be a typo.
+func (e SetMembershipError) Suggestion() (Literal, bool) {
+	if e.Literal.String == "" {
+		return Literal{}, false
+	}
+
+	var (
+		best     Literal
+		bestDist = -1
+	)
+
+	// Comparisons are always made without regard to case, such that a value
+	// that differs only in case from a member of a case-sensitive set is
+	// treated as a near miss.
+	v := strings.ToLower(e.Literal.String)
+
+	consider := func(c Literal) {
+		d := editdistance.Distance(v, strings.ToLower(c.String))
+		if !editdistance.IsNearMiss(c.String, d) {
+			return
+		}
+		if bestDist == -1 || d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+
+	for _, m := range e.Set.Literals() {
+		consider(m)
+		for _, a := range e.Set.Aliases(m) {
+			consider(a)
+		}
+	}
+
+	return best, bestDist != -1
+}
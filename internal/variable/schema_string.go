@@ -1,6 +1,7 @@
 package variable
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"reflect"
@@ -12,11 +13,16 @@ import (
 // String is a schema that allows arbitrary string input.
 type String interface {
 	LengthLimited
+
+	// Rules returns the declarative rules that the value must satisfy in
+	// addition to its length limits.
+	Rules() []StringRule
 }
 
 // TypedString is a string value depicted by type T.
 type TypedString[T ~string] struct {
 	MinLen, MaxLen maybe.Value[int]
+	StringRules    []StringRule
 }
 
 // MinLength returns the minimum permitted length of the native value.
@@ -29,6 +35,12 @@ func (s TypedString[T]) MaxLength() (int, bool) {
 	return s.MaxLen.Get()
 }
 
+// Rules returns the declarative rules that the value must satisfy in addition
+// to its length limits.
+func (s TypedString[T]) Rules() []StringRule {
+	return s.StringRules
+}
+
 // LengthDescription returns a human-readable description of the length that the
 // limit applies to.
 func (s TypedString[T]) LengthDescription() string {
@@ -59,6 +71,31 @@ func (s TypedString[T]) Finalize() error {
 		}
 	}
 
+	for _, r := range s.StringRules {
+		switch r := r.(type) {
+		case PatternRule:
+			if r.Pattern == nil {
+				return errors.New("pattern: must not be nil")
+			}
+		case PrefixRule:
+			if r.Prefix == "" {
+				return errors.New("prefix: must not be empty")
+			}
+
+			// Make sure the prefix itself satisfies the other rules, otherwise
+			// there are no valid values.
+			for _, x := range s.StringRules {
+				if _, ok := x.(PatternRule); ok {
+					continue
+				}
+
+				if err := x.check(s, r.Prefix); err != nil {
+					return fmt.Errorf("prefix: %w", err)
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -100,20 +137,45 @@ func (s TypedString[T]) Examples(conservative bool) []TypedExample[T] {
 		max = math.MaxInt
 	}
 
-	words := [...]T{
+	words := []T{
 		"foo", "bar", "baz",
 		"qux", "quux", "corge",
 		"grault", "garply", "waldo",
 		"fred", "plugh", "xyzzy",
 	}
-
+	sep, last := T(" "), T("x")
 	var example T
+
+	// Adjust the words and separators used so that the generated example
+	// satisfies as many of the rules as possible. Pattern rules can not be
+	// satisfied in general, in which case the example is discarded by the
+	// spec builder.
+	for _, r := range s.StringRules {
+		switch r := r.(type) {
+		case PrefixRule:
+			example = T(r.Prefix)
+		case NoWhitespaceRule:
+			if sep == " " {
+				sep = "-"
+			}
+		case CharsetRule:
+			switch r.Charset {
+			case CharsetAlphanumeric:
+				sep = ""
+			case CharsetHex:
+				words = []T{"c0ffee", "deadbeef", "f00d", "cafe"}
+				sep, last = "", "f"
+			}
+		}
+	}
+
+	prefix := len(example)
 	word := 0
 
 	// Add enough words to meet the minimum requirement.
-	for len(example) < min {
-		if example != "" {
-			example += " "
+	for len(example) < min || len(example) == prefix {
+		if len(example) > prefix {
+			example += sep
 		}
 
 		example += words[word%len(words)]
@@ -121,9 +183,9 @@ func (s TypedString[T]) Examples(conservative bool) []TypedExample[T] {
 	}
 
 	// If the variable is too long truncate it to the max length, and ensure it
-	// doesn't end in a space.
+	// doesn't end in a separator.
 	if len(example) > max {
-		example = example[:max-1] + "x"
+		example = example[:max-1] + last
 	}
 
 	return []TypedExample[T]{
@@ -143,5 +205,11 @@ func (s TypedString[T]) validate(v T) error {
 		return MaxLengthError{s}
 	}
 
+	for _, r := range s.StringRules {
+		if err := r.check(s, string(v)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
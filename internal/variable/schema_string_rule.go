@@ -0,0 +1,282 @@
+package variable
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// StringRule is a declarative rule that a string value must satisfy, in
+// addition to any length limits imposed by its schema.
+type StringRule interface {
+	// AcceptVisitor passes the rule to the appropriate method of v.
+	AcceptVisitor(v StringRuleVisitor)
+
+	// check returns an error if v does not satisfy the rule.
+	check(s String, v string) SchemaError
+}
+
+// StringRuleVisitor dispatches based on the type of a StringRule.
+type StringRuleVisitor interface {
+	VisitPatternRule(PatternRule)
+	VisitPrefixRule(PrefixRule)
+	VisitCharsetRule(CharsetRule)
+	VisitNoWhitespaceRule(NoWhitespaceRule)
+}
+
+// PatternRule is a StringRule that requires the value to match a regular
+// expression.
+type PatternRule struct {
+	Pattern *regexp.Regexp
+}
+
+// AcceptVisitor passes the rule to the appropriate method of v.
+func (r PatternRule) AcceptVisitor(v StringRuleVisitor) {
+	v.VisitPatternRule(r)
+}
+
+func (r PatternRule) check(s String, v string) SchemaError {
+	if r.Pattern.MatchString(v) {
+		return nil
+	}
+	return PatternError{s, r}
+}
+
+// PrefixRule is a StringRule that requires the value to begin with a specific
+// prefix.
+type PrefixRule struct {
+	Prefix string
+}
+
+// AcceptVisitor passes the rule to the appropriate method of v.
+func (r PrefixRule) AcceptVisitor(v StringRuleVisitor) {
+	v.VisitPrefixRule(r)
+}
+
+func (r PrefixRule) check(s String, v string) SchemaError {
+	if strings.HasPrefix(v, r.Prefix) {
+		return nil
+	}
+	return PrefixError{s, r}
+}
+
+// CharsetRule is a StringRule that requires every character in the value to be
+// a member of a specific character set.
+type CharsetRule struct {
+	Charset Charset
+}
+
+// AcceptVisitor passes the rule to the appropriate method of v.
+func (r CharsetRule) AcceptVisitor(v StringRuleVisitor) {
+	v.VisitCharsetRule(r)
+}
+
+func (r CharsetRule) check(s String, v string) SchemaError {
+	for i, ch := range v {
+		if !r.Charset.Contains(ch) {
+			return CharsetError{s, r, ch, i}
+		}
+	}
+	return nil
+}
+
+// NoWhitespaceRule is a StringRule that requires the value to not contain any
+// whitespace characters.
+type NoWhitespaceRule struct{}
+
+// AcceptVisitor passes the rule to the appropriate method of v.
+func (r NoWhitespaceRule) AcceptVisitor(v StringRuleVisitor) {
+	v.VisitNoWhitespaceRule(r)
+}
+
+func (r NoWhitespaceRule) check(s String, v string) SchemaError {
+	for i, ch := range v {
+		if unicode.IsSpace(ch) {
+			return WhitespaceError{s, i}
+		}
+	}
+	return nil
+}
+
+// Charset is an enumeration of the character sets that may be used with a
+// CharsetRule.
+type Charset int
+
+const (
+	// CharsetAlphanumeric is the set of ASCII letters and digits.
+	CharsetAlphanumeric Charset = iota
+
+	// CharsetHex is the set of hexadecimal digits, in either case.
+	CharsetHex
+
+	// CharsetASCIIPrintable is the set of printable ASCII characters, including
+	// the space character.
+	CharsetASCIIPrintable
+)
+
+// Contains returns true if ch is a member of the character set.
+func (c Charset) Contains(ch rune) bool {
+	switch c {
+	case CharsetAlphanumeric:
+		return ch >= 'a' && ch <= 'z' ||
+			ch >= 'A' && ch <= 'Z' ||
+			ch >= '0' && ch <= '9'
+	case CharsetHex:
+		return ch >= 'a' && ch <= 'f' ||
+			ch >= 'A' && ch <= 'F' ||
+			ch >= '0' && ch <= '9'
+	case CharsetASCIIPrintable:
+		return ch >= ' ' && ch <= '~'
+	default:
+		panic("unrecognized charset")
+	}
+}
+
+// Description returns a human-readable description of the characters in the
+// set.
+//
+// It must produce a gramatical sentence of the form:
+//
+//	"The value must contain only <desc>."
+func (c Charset) Description() string {
+	switch c {
+	case CharsetAlphanumeric:
+		return "ASCII letters and digits"
+	case CharsetHex:
+		return "hexadecimal digits"
+	case CharsetASCIIPrintable:
+		return "printable ASCII characters"
+	default:
+		panic("unrecognized charset")
+	}
+}
+
+// Class returns the POSIX character class that is equivalent to the set.
+func (c Charset) Class() string {
+	switch c {
+	case CharsetAlphanumeric:
+		return "[:alnum:]"
+	case CharsetHex:
+		return "[:xdigit:]"
+	case CharsetASCIIPrintable:
+		return "[:print:]"
+	default:
+		panic("unrecognized charset")
+	}
+}
+
+// PatternError indicates that a string value did not match the pattern
+// required by a PatternRule.
+type PatternError struct {
+	ViolatedSchema String
+	Rule           PatternRule
+}
+
+var _ SchemaError = PatternError{}
+
+// Schema returns the schema that was violated.
+func (e PatternError) Schema() Schema {
+	return e.ViolatedSchema
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e PatternError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitPatternError(e)
+}
+
+func (e PatternError) Error() string {
+	return fmt.Sprintf(
+		"expected a value matching the pattern /%s/",
+		e.Rule.Pattern,
+	)
+}
+
+// PrefixError indicates that a string value did not begin with the prefix
+// required by a PrefixRule.
+type PrefixError struct {
+	ViolatedSchema String
+	Rule           PrefixRule
+}
+
+var _ SchemaError = PrefixError{}
+
+// Schema returns the schema that was violated.
+func (e PrefixError) Schema() Schema {
+	return e.ViolatedSchema
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e PrefixError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitPrefixError(e)
+}
+
+func (e PrefixError) Error() string {
+	return fmt.Sprintf(
+		"expected a value beginning with %s",
+		Literal{String: e.Rule.Prefix}.Quote(),
+	)
+}
+
+// CharsetError indicates that a string value contained a character that is not
+// a member of the character set required by a CharsetRule.
+type CharsetError struct {
+	ViolatedSchema String
+	Rule           CharsetRule
+
+	// Char is the first character that is not a member of the set.
+	Char rune
+
+	// Offset is the byte offset of Char within the value.
+	Offset int
+}
+
+var _ SchemaError = CharsetError{}
+
+// Schema returns the schema that was violated.
+func (e CharsetError) Schema() Schema {
+	return e.ViolatedSchema
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e CharsetError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitCharsetError(e)
+}
+
+func (e CharsetError) Error() string {
+	return fmt.Sprintf(
+		"unexpected character %q at position %d, expected only %s",
+		e.Char,
+		e.Offset+1,
+		e.Rule.Charset.Description(),
+	)
+}
+
+// WhitespaceError indicates that a string value contained whitespace, which is
+// forbidden by a NoWhitespaceRule.
+type WhitespaceError struct {
+	ViolatedSchema String
+
+	// Offset is the byte offset of the first whitespace character within the
+	// value.
+	Offset int
+}
+
+var _ SchemaError = WhitespaceError{}
+
+// Schema returns the schema that was violated.
+func (e WhitespaceError) Schema() Schema {
+	return e.ViolatedSchema
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e WhitespaceError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitWhitespaceError(e)
+}
+
+func (e WhitespaceError) Error() string {
+	return fmt.Sprintf(
+		"unexpected whitespace at position %d",
+		e.Offset+1,
+	)
+}
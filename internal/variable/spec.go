@@ -3,6 +3,7 @@ package variable
 import (
 	"fmt"
 
+	"github.com/dogmatiq/ferrite/internal/environment"
 	"github.com/dogmatiq/ferrite/internal/maybe"
 )
 
@@ -23,6 +24,11 @@ type Spec interface {
 	// Default returns the string representation of the default value.
 	Default() (Literal, bool)
 
+	// HasDerivedDefault returns true if the variable's default value is
+	// computed when the variable is resolved, typically from the values of
+	// other variables.
+	HasDerivedDefault() bool
+
 	// IsRequired returns true if the application MUST have a value for this
 	// variable (even if it is fulfilled by a default value).
 	IsRequired() bool
@@ -34,6 +40,10 @@ type Spec interface {
 	// IsDeprecated returns true if the variable is deprecated.
 	IsDeprecated() bool
 
+	// Deprecation returns information about the lifecycle of a deprecated
+	// variable.
+	Deprecation() Deprecation
+
 	// Constraints returns a list of additional constraints on the variable's
 	// value.
 	Constraints() []Constraint
@@ -46,11 +56,27 @@ type Spec interface {
 	// Documentation returns a list of chunks of documentation text.
 	Documentation() []Documentation
 
+	// CrossConstraints returns a list of constraints that involve this
+	// variable and others.
+	CrossConstraints() []*CrossConstraint
+
 	// Relationships returns a list of relationships that involve this variable.
 	Relationships() []Relationship
 
 	// addRelationship adds a relationship that involves this variable.
 	addRelationship(r Relationship)
+
+	// addCrossConstraint adds a constraint that involves this variable.
+	addCrossConstraint(c *CrossConstraint)
+
+	// isExplicit returns true if the variable is explicitly defined in the
+	// environment.
+	isExplicit() bool
+
+	// hasValue returns true if the variable is explicitly defined in the
+	// environment or has a default value, including a default value that is
+	// derived from other variables or taken from the active profile.
+	hasValue() bool
 }
 
 // IsDefault returns true if v is the default value of the given spec.
@@ -64,18 +90,25 @@ func IsDefault(s Spec, v Literal) bool {
 
 // TypedSpec builds a specification for a variable depicted by type T.
 type TypedSpec[T any] struct {
-	name          string
-	desc          string
-	def           maybe.Value[valueOf[T]]
-	required      bool
-	sensitive     bool
-	deprecated    bool
-	schema        TypedSchema[T]
-	examples      []Example
-	docs          []Documentation
-	constraints   []TypedConstraint[T]
-	relationships []Relationship
-	preconditions []func() bool
+	name             string
+	desc             string
+	def              maybe.Value[valueOf[T]]
+	derivedDef       func() (T, bool)
+	profileDefs      []typedProfileDefault[T]
+	required         bool
+	sensitive        bool
+	deprecated       bool
+	deprecation      Deprecation
+	schema           TypedSchema[T]
+	examples         []Example
+	docs             []Documentation
+	constraints      []TypedConstraint[T]
+	crossConstraints []*CrossConstraint
+	relationships    []Relationship
+	preconditions    []func() bool
+	requirements     []func() bool
+	normalizers      []func(Literal) Literal
+	observers        []func(T)
 }
 
 // Name returns the name of the variable.
@@ -108,6 +141,12 @@ func (s *TypedSpec[T]) Default() (Literal, bool) {
 	return maybe.Map(s.def, valueOf[T].Canonical).Get()
 }
 
+// HasDerivedDefault returns true if the variable's default value is computed
+// when the variable is resolved, typically from the values of other variables.
+func (s *TypedSpec[T]) HasDerivedDefault() bool {
+	return s.derivedDef != nil
+}
+
 // IsRequired returns true if the application MUST have a value for this
 // variable (even if it is fulfilled by a default value).
 func (s *TypedSpec[T]) IsRequired() bool {
@@ -125,6 +164,12 @@ func (s *TypedSpec[T]) IsDeprecated() bool {
 	return s.deprecated
 }
 
+// Deprecation returns information about the lifecycle of a deprecated
+// variable.
+func (s *TypedSpec[T]) Deprecation() Deprecation {
+	return s.deprecation
+}
+
 // Constraints returns a list of additional constraints on the variable's
 // value.
 func (s *TypedSpec[T]) Constraints() []Constraint {
@@ -135,6 +180,17 @@ func (s *TypedSpec[T]) Constraints() []Constraint {
 	return constraints
 }
 
+// CrossConstraints returns a list of constraints that involve this variable
+// and others.
+func (s *TypedSpec[T]) CrossConstraints() []*CrossConstraint {
+	return s.crossConstraints
+}
+
+// addCrossConstraint adds a constraint that involves this variable.
+func (s *TypedSpec[T]) addCrossConstraint(c *CrossConstraint) {
+	s.crossConstraints = append(s.crossConstraints, c)
+}
+
 // Examples returns a list of examples of valid values.
 func (s *TypedSpec[T]) Examples() []Example {
 	return s.examples
@@ -155,6 +211,60 @@ func (s *TypedSpec[T]) addRelationship(r Relationship) {
 	s.relationships = append(s.relationships, r)
 }
 
+// isExplicit returns true if the variable is explicitly defined in the
+// environment.
+//
+// Unlike [OfType.Source], it does not resolve the variable's value, and hence
+// may be called while resolving other variables.
+func (s *TypedSpec[T]) isExplicit() bool {
+	lit := Literal{String: environment.Get(s.name)}
+	return s.Normalize(lit).String != ""
+}
+
+// hasValue returns true if the variable is explicitly defined in the
+// environment or has a default value, including a default value that is
+// derived from other variables or taken from the active profile.
+func (s *TypedSpec[T]) hasValue() bool {
+	if s.isExplicit() || !s.def.IsEmpty() {
+		return true
+	}
+
+	def, _ := s.deriveDefault()
+	return !def.IsEmpty()
+}
+
+// deriveDefault computes the variable's default value from the values of other
+// variables.
+//
+// The default value is taken from the active profile, if there is one,
+// otherwise it is computed using the derived default function, if any.
+func (s *TypedSpec[T]) deriveDefault() (maybe.Value[T], maybe.Value[Literal]) {
+	for _, d := range s.profileDefs {
+		if d.profile.IsActive() {
+			return maybe.Some(d.value.native), maybe.Some(d.value.canonical)
+		}
+	}
+
+	if s.derivedDef == nil {
+		return maybe.None[T](), maybe.None[Literal]()
+	}
+
+	n, ok := s.derivedDef()
+	if !ok {
+		return maybe.None[T](), maybe.None[Literal]()
+	}
+
+	// Marshal without checking constraints, any violations are reported when
+	// the resolution is built. If the value can not be marshaled at all, fall
+	// back to a best-effort representation for use in error messages.
+	l, err := s.schema.Marshal(n)
+	if err != nil {
+		l = Literal{String: fmt.Sprint(n)}
+	}
+
+	return maybe.Some(n), maybe.Some(l)
+}
+
 // CheckConstraints returns an error if v does not satisfy any one of the
 // specification's constraints.
 func (s *TypedSpec[T]) CheckConstraints(ctx ConstraintContext, v T) ConstraintError {
@@ -179,8 +289,19 @@ func (s *TypedSpec[T]) Marshal(ctx ConstraintContext, v T) (Literal, error) {
 	return s.schema.Marshal(v)
 }
 
+// Normalize applies the specification's normalizers to v, in the order they
+// were added.
+func (s *TypedSpec[T]) Normalize(v Literal) Literal {
+	for _, fn := range s.normalizers {
+		v = fn(v)
+	}
+	return v
+}
+
 // Unmarshal converts a literal value to it's native representation.
 //
+// v must already be normalized; see [TypedSpec.Normalize].
+//
 // It returns an error if v does not meet the specification's constraints or
 // unmarshaling fails at the schema level.
 func (s *TypedSpec[T]) Unmarshal(ctx ConstraintContext, v Literal) (T, Literal, error) {
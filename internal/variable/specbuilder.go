@@ -13,17 +13,28 @@ type SpecBuilder interface {
 	Description(string)
 	MarkRequired()
 	MarkDeprecated()
+	Deprecation() *Deprecation
 	MarkSensitive()
 	Documentation() DocumentationBuilder
 	Precondition(func() bool)
+	Requirement(func() bool)
 	Peek() Spec
 }
 
 // TypedSpecBuilder builds a specification for a variable of type T.
 type TypedSpecBuilder[T any] struct {
-	spec     TypedSpec[T]
-	def      maybe.Value[T]
-	examples []TypedExample[T]
+	spec        TypedSpec[T]
+	def         maybe.Value[T]
+	derivedFrom []Spec
+	profileDefs []profileDefault[T]
+	examples    []TypedExample[T]
+}
+
+// profileDefault is a profile-specific default value that has not yet been
+// validated.
+type profileDefault[T any] struct {
+	profile *Profile
+	value   T
 }
 
 // Name sets the name of the environment variable.
@@ -39,6 +50,35 @@ func (b *TypedSpecBuilder[T]) Description(desc string) {
 // Default sets the default value for the variable.
 func (b *TypedSpecBuilder[T]) Default(v T) {
 	b.def = maybe.Some(v)
+	b.spec.derivedDef = nil
+	b.derivedFrom = nil
+}
+
+// DerivedDefault sets a function that computes the variable's default value.
+//
+// fn is called each time the variable is resolved while it is undefined. If ok
+// is false the variable is treated as though it has no default value. from is
+// the list of variables that the default value is derived from, if any.
+func (b *TypedSpecBuilder[T]) DerivedDefault(fn func() (v T, ok bool), from ...Spec) {
+	b.def = maybe.None[T]()
+	b.spec.derivedDef = fn
+	b.derivedFrom = from
+}
+
+// ProfileDefault sets the default value for the variable that is used when the
+// profile p is active.
+//
+// It takes precedence over any default value set using Default() or
+// DerivedDefault().
+func (b *TypedSpecBuilder[T]) ProfileDefault(p *Profile, v T) {
+	for i, d := range b.profileDefs {
+		if d.profile == p {
+			b.profileDefs[i].value = v
+			return
+		}
+	}
+
+	b.profileDefs = append(b.profileDefs, profileDefault[T]{p, v})
 }
 
 // BuiltInConstraint adds a constraint to the variable's value.
@@ -91,6 +131,12 @@ func (b *TypedSpecBuilder[T]) MarkDeprecated() {
 	b.spec.deprecated = true
 }
 
+// Deprecation returns the deprecation information for the variable, which may
+// be modified in-place.
+func (b *TypedSpecBuilder[T]) Deprecation() *Deprecation {
+	return &b.spec.deprecation
+}
+
 // NormativeExample adds a normative example to the variable.
 //
 // A normative example is one that is meaningful in the context of the
@@ -129,6 +175,33 @@ func (b *TypedSpecBuilder[T]) Precondition(fn func() bool) {
 	b.spec.preconditions = append(b.spec.preconditions, fn)
 }
 
+// Requirement adds a predicate that causes an otherwise optional variable to
+// be required.
+//
+// If any requirement is satisfied and the variable is undefined, the variable
+// is treated as though it were required.
+func (b *TypedSpecBuilder[T]) Requirement(fn func() bool) {
+	b.spec.requirements = append(b.spec.requirements, fn)
+}
+
+// Normalizer adds a function that transforms the variable's value before it is
+// unmarshaled.
+//
+// Normalizers are applied in the order they are added. If the normalized value
+// is empty the variable is treated as though it were undefined.
+func (b *TypedSpecBuilder[T]) Normalizer(fn func(Literal) Literal) {
+	b.spec.normalizers = append(b.spec.normalizers, fn)
+}
+
+// Observer adds a function that is called with the variable's value each time
+// it is resolved from a changed environment.
+//
+// fn is not called if the variable is undefined without a default value, or if
+// its value is invalid.
+func (b *TypedSpecBuilder[T]) Observer(fn func(T)) {
+	b.spec.observers = append(b.spec.observers, fn)
+}
+
 // Peek returns the (potentially invalid) spec that is being built.
 func (b *TypedSpecBuilder[T]) Peek() Spec {
 	return &b.spec
@@ -181,6 +254,28 @@ func (b *TypedSpecBuilder[T]) finalize() error {
 		})
 	}
 
+	for _, d := range b.profileDefs {
+		lit, err := b.spec.Marshal(ConstraintContextDefault, d.value)
+		if err != nil {
+			return SpecError{
+				name: b.spec.name,
+				cause: fmt.Errorf(
+					"default value for the %s profile: %w",
+					d.profile.Value.Quote(),
+					err,
+				),
+			}
+		}
+
+		b.spec.profileDefs = append(b.spec.profileDefs, typedProfileDefault[T]{
+			profile: d.profile,
+			value: valueOf[T]{
+				native:    d.value,
+				canonical: lit,
+			},
+		})
+	}
+
 	if err := b.buildExamples(); err != nil {
 		return SpecError{
 			name:  b.spec.name,
@@ -188,6 +283,33 @@ func (b *TypedSpecBuilder[T]) finalize() error {
 		}
 	}
 
+	for _, s := range b.derivedFrom {
+		EstablishRelationships(
+			DerivesDefaultFrom{
+				Subject:            &b.spec,
+				DerivesDefaultFrom: s,
+			},
+			RefersTo{
+				Subject:  &b.spec,
+				RefersTo: s,
+			},
+		)
+	}
+
+	for _, d := range b.spec.profileDefs {
+		EstablishRelationships(
+			ProfileDefault{
+				Subject: &b.spec,
+				Profile: d.profile,
+				Default: d.value.canonical,
+			},
+			RefersTo{
+				Subject:  &b.spec,
+				RefersTo: d.profile.Selector,
+			},
+		)
+	}
+
 	return nil
 }
 
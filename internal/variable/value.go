@@ -74,14 +74,16 @@ type ValueError interface {
 type ValueErrorVisitor interface {
 	SchemaErrorVisitor
 
+	VisitMutuallyExclusiveError(MutuallyExclusiveError)
 	VisitGenericError(error)
 }
 
 // valueError indicates that there is a problem with a variable's value.
 type valueError struct {
-	name    string
-	literal Literal
-	cause   error
+	name      string
+	literal   Literal
+	cause     error
+	isDefault bool
 }
 
 func (e valueError) Name() string {
@@ -100,12 +102,23 @@ func (e valueError) AcceptVisitor(v ValueErrorVisitor) {
 	switch err := e.cause.(type) {
 	case SchemaError:
 		err.AcceptVisitor(v)
+	case MutuallyExclusiveError:
+		v.VisitMutuallyExclusiveError(err)
 	default:
 		v.VisitGenericError(err)
 	}
 }
 
 func (e valueError) Error() string {
+	if e.isDefault {
+		return fmt.Sprintf(
+			"default value of %s (%s) is invalid: %s",
+			e.name,
+			e.literal.Quote(),
+			e.cause,
+		)
+	}
+
 	return fmt.Sprintf(
 		"value of %s (%s) is invalid: %s",
 		e.name,
@@ -2,10 +2,12 @@ package variable
 
 import (
 	"fmt"
+	"slices"
 	"sync"
 	"sync/atomic"
 
 	"github.com/dogmatiq/ferrite/internal/environment"
+	"github.com/dogmatiq/ferrite/internal/maybe"
 )
 
 // Availability is an enumeration describing why a variable is or is not
@@ -67,10 +69,12 @@ type OfType[T any] struct {
 
 // resolution holds the cached result of resolving an environment variable.
 type resolution[T any] struct {
-	lit    string
-	source Source
-	value  valueOf[T]
-	err    Error
+	lit      string
+	derived  maybe.Value[Literal]
+	excluded []bool
+	source   Source
+	value    valueOf[T]
+	err      Error
 }
 
 // Spec returns the variable's specification.
@@ -127,16 +131,29 @@ func (v *OfType[T]) NativeValue() T {
 //
 // The error is nil if the variable is in a valid state, which occurs when it
 // has an availability of AvailabilityOK, or if it has an availability of
-// AvailabilityNone and v.Spec().IsRequired() is false.
+// AvailabilityNone, v.Spec().IsRequired() is false and none of the spec's
+// requirements are satisfied.
 func (v *OfType[T]) Error() Error {
-	return v.resolve().err
+	r := v.resolve()
+
+	if r.err == nil && r.source == SourceNone {
+		for _, fn := range v.TypedSpec.requirements {
+			if fn() {
+				return ConditionalRequirementError{v.TypedSpec}
+			}
+		}
+	}
+
+	return r.err
 }
 
 func (v *OfType[T]) resolve() *resolution[T] {
 	lit := environment.Get(v.TypedSpec.name)
+	derived, derivedLit := v.derive(lit)
+	excluded := v.excluded()
 
 	if r := v.resolution.Load(); r != nil {
-		if r.lit == lit {
+		if r.lit == lit && r.derived == derivedLit && slices.Equal(r.excluded, excluded) {
 			return r
 		}
 	}
@@ -145,17 +162,39 @@ func (v *OfType[T]) resolve() *resolution[T] {
 	defer v.m.Unlock()
 
 	if r := v.resolution.Load(); r != nil {
-		if r.lit == lit {
+		if r.lit == lit && r.derived == derivedLit && slices.Equal(r.excluded, excluded) {
 			return r
 		}
 	}
 
 	r := &resolution[T]{
-		lit: lit,
+		lit:      lit,
+		derived:  derivedLit,
+		excluded: excluded,
 	}
 
-	if lit == "" {
-		if def, ok := v.TypedSpec.def.Get(); ok {
+	verbatim := Literal{String: lit}
+	norm := v.TypedSpec.Normalize(verbatim)
+
+	if norm.String == "" {
+		if n, ok := derived.Get(); ok {
+			r.source = SourceDefault
+
+			c, err := v.TypedSpec.Marshal(ConstraintContextDefault, n)
+			if err != nil {
+				r.err = valueError{
+					name:      v.TypedSpec.name,
+					literal:   derivedLit.MustGet(),
+					cause:     err,
+					isDefault: true,
+				}
+			} else {
+				r.value = valueOf[T]{
+					native:    n,
+					canonical: c,
+				}
+			}
+		} else if def, ok := v.TypedSpec.def.Get(); ok {
 			r.source = SourceDefault
 			r.value = def
 		} else if v.TypedSpec.required {
@@ -164,26 +203,125 @@ func (v *OfType[T]) resolve() *resolution[T] {
 	} else {
 		r.source = SourceEnvironment
 
-		n, c, err := v.TypedSpec.Unmarshal(ConstraintContextFinal, Literal{String: lit})
+		n, c, err := v.TypedSpec.Unmarshal(ConstraintContextFinal, norm)
 		if err != nil {
 			r.err = valueError{
 				name:    v.TypedSpec.name,
-				literal: Literal{String: lit},
+				literal: verbatim,
 				cause:   err,
 			}
+		} else if conflicts := v.conflicts(); len(conflicts) != 0 {
+			r.err = valueError{
+				name:    v.TypedSpec.name,
+				literal: verbatim,
+				cause:   MutuallyExclusiveError{conflicts},
+			}
 		} else {
 			r.value = valueOf[T]{
-				verbatim:  Literal{String: lit},
+				verbatim:  verbatim,
 				native:    n,
 				canonical: c,
 			}
 		}
 	}
 
+	if r.err == nil && r.source == SourceNone {
+		if names, ok := v.alternatives(); !ok {
+			r.err = ExactlyOneError{v.TypedSpec.name, names}
+		}
+	}
+
+	if r.err == nil && r.source != SourceNone {
+		for _, fn := range v.TypedSpec.observers {
+			fn(r.value.native)
+		}
+	}
+
 	v.resolution.Store(r)
 	return r
 }
 
+// derive computes the variable's default value from the values of other
+// variables, if lit, the variable's value in the environment, is empty.
+//
+// The default value is taken from the active profile, if there is one,
+// otherwise it is computed using the derived default function, if any.
+//
+// The derived value depends on the values of other variables, so it must be
+// recomputed each time the variable is resolved. Its literal representation is
+// used to determine whether a previous resolution is still current.
+func (v *OfType[T]) derive(lit string) (maybe.Value[T], maybe.Value[Literal]) {
+	s := v.TypedSpec
+	if s.derivedDef == nil && len(s.profileDefs) == 0 {
+		return maybe.None[T](), maybe.None[Literal]()
+	}
+
+	if s.Normalize(Literal{String: lit}).String != "" {
+		return maybe.None[T](), maybe.None[Literal]()
+	}
+
+	return s.deriveDefault()
+}
+
+// excluded returns whether each of the variables that are mutually exclusive
+// with v is defined in the environment, and for those that may be used in
+// place of v, whether they have a value.
+//
+// The result determines whether v conflicts with, or is satisfied by, the
+// other variables, so it is used to determine whether a previous resolution
+// is still current.
+func (v *OfType[T]) excluded() []bool {
+	var excluded []bool
+
+	for _, rel := range Exclusions(v.TypedSpec) {
+		excluded = append(excluded, rel.MutuallyExclusiveWith.isExplicit())
+
+		if rel.ExactlyOne {
+			excluded = append(excluded, rel.MutuallyExclusiveWith.hasValue())
+		}
+	}
+
+	return excluded
+}
+
+// conflicts returns the specifications of the variables that are mutually
+// exclusive with v and are defined in the environment.
+func (v *OfType[T]) conflicts() []Spec {
+	var conflicts []Spec
+
+	for _, rel := range Exclusions(v.TypedSpec) {
+		if rel.MutuallyExclusiveWith.isExplicit() {
+			conflicts = append(conflicts, rel.MutuallyExclusiveWith)
+		}
+	}
+
+	return conflicts
+}
+
+// alternatives checks whether any of the variables that may be used in place
+// of v, which is undefined, have a value.
+//
+// It returns the names of v and its alternatives. ok is false if v must have
+// been defined because none of its alternatives have a value.
+func (v *OfType[T]) alternatives() (names []string, ok bool) {
+	names = []string{v.TypedSpec.name}
+
+	for _, rel := range Exclusions(v.TypedSpec) {
+		if !rel.ExactlyOne {
+			continue
+		}
+
+		alt := rel.MutuallyExclusiveWith
+		if alt.hasValue() {
+			return nil, true
+		}
+
+		names = append(names, alt.Name())
+	}
+
+	return names, len(names) == 1
+}
+
 // undefinedError is an Error that indicates that a variable is undefined and
 // does not have a default value.
 type undefinedError struct {
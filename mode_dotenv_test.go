@@ -136,3 +136,140 @@ func ExampleInit_exportDotEnvFile() {
 	// export FERRITE_URL= # https//example.org is invalid: URL must have a scheme
 	// <process exited successfully>
 }
+
+func ExampleInit_exportDotEnvFileWithDeprecatedVariables() {
+	defer example()()
+
+	ferrite.
+		Bool("FERRITE_DEBUG", "enable debug logging").
+		Deprecated(
+			ferrite.DeprecatedSince("v1.4.0"),
+			ferrite.RemovedIn("v2.0.0"),
+			ferrite.SunsetAfter(time.Date(2100, 6, 30, 0, 0, 0, 0, time.UTC)),
+		)
+
+	ferrite.
+		Bool("FERRITE_TRACE", "enable trace logging").
+		WithDefault(false).
+		Deprecated(
+			ferrite.RemovedIn("v2.0.0"),
+		)
+
+	// Tell ferrite to export an env file containing the environment variables.
+	os.Setenv("FERRITE_MODE", "export/dotenv")
+
+	ferrite.Init()
+
+	// Output:
+	// # enable debug logging (deprecated since v1.4.0, removed in v2.0.0, sunset after 2100-06-30)
+	// export FERRITE_DEBUG=
+	//
+	// # enable trace logging (default: false, deprecated, removed in v2.0.0)
+	// export FERRITE_TRACE=
+	// <process exited successfully>
+}
+
+func ExampleInit_exportDotEnvFileWithDerivedDefaults() {
+	defer example()()
+
+	httpPort := ferrite.
+		Unsigned[uint16]("FERRITE_HTTP_PORT", "the port for the HTTP server").
+		Required()
+
+	ferrite.
+		WithDefaultFrom(
+			ferrite.Unsigned[uint16]("FERRITE_METRICS_PORT", "the port for the metrics server"),
+			httpPort,
+			func(p uint16) uint16 {
+				return p + 1
+			},
+		).
+		Required()
+
+	ferrite.
+		WithDefaultFrom(
+			ferrite.Unsigned[uint16]("FERRITE_ADMIN_PORT", "the port for the admin server").
+				WithMaximum(8000),
+			httpPort,
+			func(p uint16) uint16 {
+				return p + 2
+			},
+		).
+		Required()
+
+	ferrite.
+		String("FERRITE_HOSTNAME", "the name of the host").
+		WithDefaultFunc(
+			func() (string, bool) {
+				return "host.example.org", true
+			},
+		).
+		Required()
+
+	os.Setenv("FERRITE_HTTP_PORT", "8080")
+
+	// Tell ferrite to export an env file containing the environment variables.
+	os.Setenv("FERRITE_MODE", "export/dotenv")
+
+	ferrite.Init()
+
+	// Output:
+	// # the port for the admin server (default: derived from FERRITE_HTTP_PORT)
+	// export FERRITE_ADMIN_PORT= # default of 8082 is invalid: too high, expected 8000 or less
+	//
+	// # the name of the host (default: computed at runtime)
+	// export FERRITE_HOSTNAME= # defaults to host.example.org
+	//
+	// # the port for the HTTP server (required)
+	// export FERRITE_HTTP_PORT=8080
+	//
+	// # the port for the metrics server (default: derived from FERRITE_HTTP_PORT)
+	// export FERRITE_METRICS_PORT= # defaults to 8081
+	// <process exited successfully>
+}
+
+func ExampleInit_exportDotEnvFileWithProfileDefaults() {
+	defer example()()
+
+	env := ferrite.
+		Enum("FERRITE_APP_ENV", "the environment in which the application is running").
+		WithMembers("development", "staging", "production").
+		WithDefault("development").
+		Required()
+
+	staging := ferrite.ProfileWhen(env, "staging")
+	production := ferrite.ProfileWhen(env, "production")
+
+	ferrite.
+		NetworkPort("FERRITE_HTTP_PORT", "the port for the HTTP server").
+		WithDefault("8080").
+		WithDefaultIn(staging, "8081").
+		WithDefaultIn(production, "80").
+		Required()
+
+	ferrite.
+		String("FERRITE_LOG_LEVEL", "the minimum log level").
+		WithDefaultIn(production, "info").
+		Optional()
+
+	os.Setenv("FERRITE_APP_ENV", "production")
+
+	// Tell ferrite to export an env file containing the environment variables.
+	os.Setenv("FERRITE_MODE", "export/dotenv")
+
+	ferrite.Init()
+
+	// Output:
+	// # the environment in which the application is running (default: development)
+	// export FERRITE_APP_ENV=production
+	//
+	// # the port for the HTTP server (default: 8080)
+	// # default when FERRITE_APP_ENV is staging: 8081
+	// # default when FERRITE_APP_ENV is production: 80
+	// export FERRITE_HTTP_PORT= # defaults to 80
+	//
+	// # the minimum log level (optional)
+	// # default when FERRITE_APP_ENV is production: info
+	// export FERRITE_LOG_LEVEL= # defaults to info
+	// <process exited successfully>
+}
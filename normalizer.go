@@ -0,0 +1,105 @@
+package ferrite
+
+import (
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/inflect"
+	"github.com/dogmatiq/ferrite/internal/variable"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalizer is a transformation that is applied to an environment variable's
+// value before it is parsed and validated.
+//
+// The original value is still shown in validation output, along with the
+// normalized (canonical) value if the two differ.
+type Normalizer struct {
+	desc string
+	fn   func(string) string
+}
+
+// TrimSpace returns a [Normalizer] that removes leading and trailing whitespace
+// from the value.
+//
+// If the value consists entirely of whitespace the variable is treated as
+// though it were undefined.
+func TrimSpace() Normalizer {
+	return Normalizer{
+		"leading and trailing whitespace is removed",
+		strings.TrimSpace,
+	}
+}
+
+// ToLower returns a [Normalizer] that converts the value to lowercase.
+func ToLower() Normalizer {
+	return Normalizer{
+		"the value is converted to lowercase",
+		strings.ToLower,
+	}
+}
+
+// ToUpper returns a [Normalizer] that converts the value to uppercase.
+func ToUpper() Normalizer {
+	return Normalizer{
+		"the value is converted to uppercase",
+		strings.ToUpper,
+	}
+}
+
+// FoldCase returns a [Normalizer] that applies Unicode case folding to the
+// value.
+//
+// Case folding is intended for caseless comparison. It is similar to
+// converting the value to lowercase, but also maps characters that have no
+// distinct lowercase form, such as "ß", to a common representation.
+func FoldCase() Normalizer {
+	return Normalizer{
+		"the value is case-folded",
+		func(s string) string {
+			return cases.Fold().String(s)
+		},
+	}
+}
+
+// UnicodeNFC returns a [Normalizer] that converts the value to Unicode
+// Normalization Form C (canonical composition).
+func UnicodeNFC() Normalizer {
+	return Normalizer{
+		"the value is converted to Unicode normalization form C (NFC)",
+		norm.NFC.String,
+	}
+}
+
+// addNormalizers adds normalizers to the spec being built by b, and documents
+// their effect.
+func addNormalizers[T any](
+	b *variable.TypedSpecBuilder[T],
+	normalizers []Normalizer,
+) {
+	if len(normalizers) == 0 {
+		return
+	}
+
+	for _, n := range normalizers {
+		fn := n.fn
+		b.Normalizer(
+			func(v variable.Literal) variable.Literal {
+				return variable.Literal{
+					String: fn(v.String),
+				}
+			},
+		)
+	}
+
+	var descs []string
+	for _, n := range normalizers {
+		descs = append(descs, n.desc)
+	}
+
+	b.Documentation().
+		Paragraph("Before the value is validated, %s.").
+		Format(inflect.AndList(descs)).
+		Important().
+		Done()
+}
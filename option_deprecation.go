@@ -0,0 +1,87 @@
+package ferrite
+
+import (
+	"time"
+
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// DeprecatedSince is an option for a deprecated variable set that records the
+// version of the application in which the variables were deprecated.
+func DeprecatedSince(version string, _ ...DeprecatedSinceOption) DeprecatedOption {
+	if err := variable.CheckVersion(version); err != nil {
+		panic(err.Error())
+	}
+
+	return option{
+		ApplyToSpecInDeprecatedSet: func(b variable.SpecBuilder) {
+			b.Deprecation().Since = version
+		},
+	}
+}
+
+// RemovedIn is an option for a deprecated variable set that records the
+// version of the application in which the variables are no longer supported.
+//
+// If the application's version, as specified by [WithAppVersion], is equal to
+// or greater than version, defining any of the variables is reported as an
+// error instead of a warning.
+func RemovedIn(version string, _ ...RemovedInOption) DeprecatedOption {
+	if err := variable.CheckVersion(version); err != nil {
+		panic(err.Error())
+	}
+
+	return option{
+		ApplyToSpecInDeprecatedSet: func(b variable.SpecBuilder) {
+			b.Deprecation().RemovedIn = version
+		},
+	}
+}
+
+// SunsetAfter is an option for a deprecated variable set that records the
+// time after which the variables are no longer supported.
+//
+// Once t has passed, defining any of the variables is reported as an error
+// instead of a warning.
+func SunsetAfter(t time.Time, _ ...SunsetAfterOption) DeprecatedOption {
+	if t.IsZero() {
+		panic("sunset time must not be zero")
+	}
+
+	return option{
+		ApplyToSpecInDeprecatedSet: func(b variable.SpecBuilder) {
+			b.Deprecation().Sunset = t
+		},
+	}
+}
+
+// WithAppVersion is an [InitOption] that sets the version of the application.
+//
+// It is compared to the version specified by the [RemovedIn] option to
+// determine whether a deprecated variable is still supported.
+func WithAppVersion(version string) InitOption {
+	if err := variable.CheckVersion(version); err != nil {
+		panic(err.Error())
+	}
+
+	return option{
+		ApplyToInitConfig: func(cfg *initConfig) {
+			cfg.ModeConfig.AppVersion = version
+		},
+	}
+}
+
+// DeprecatedSinceOption changes the behavior of the [DeprecatedSince] option.
+type DeprecatedSinceOption interface {
+	future()
+}
+
+// RemovedInOption changes the behavior of the [RemovedIn] option.
+type RemovedInOption interface {
+	future()
+}
+
+// SunsetAfterOption changes the behavior of the [SunsetAfter] option.
+type SunsetAfterOption interface {
+	future()
+}
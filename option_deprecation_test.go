@@ -0,0 +1,168 @@
+package ferrite_test
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	"github.com/dogmatiq/ferrite/internal/mode"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func DeprecatedSince()", func() {
+	It("panics if the version is invalid", func() {
+		Expect(func() {
+			DeprecatedSince("1.x")
+		}).To(PanicWith("invalid version (1.x): components must be non-negative integers"))
+	})
+})
+
+var _ = Describe("func RemovedIn()", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the version is invalid", func() {
+		Expect(func() {
+			RemovedIn("1.2.3.4")
+		}).To(PanicWith("invalid version (1.2.3.4): too many components"))
+	})
+
+	DescribeTable(
+		"it compares pre-release versions according to semantic versioning precedence",
+		func(appVersion, removedIn string, expectRemoved bool) {
+			Bool("FERRITE_DEBUG", "<desc>").
+				Deprecated(RemovedIn(removedIn))
+
+			os.Setenv("FERRITE_DEBUG", "true")
+
+			Expect(initExits(WithAppVersion(appVersion))).To(Equal(expectRemoved))
+		},
+		Entry("numeric identifiers are compared numerically", "v2.0.0-rc.10", "v2.0.0-rc.2", true),
+		Entry("numeric identifiers are compared numerically (reversed)", "v2.0.0-rc.2", "v2.0.0-rc.10", false),
+		Entry("alphanumeric identifiers are compared lexically", "v2.0.0-beta", "v2.0.0-alpha", true),
+		Entry("numeric identifiers precede alphanumeric identifiers", "v2.0.0-1", "v2.0.0-alpha", false),
+		Entry("fewer identifiers precede more identifiers", "v2.0.0-alpha", "v2.0.0-alpha.1", false),
+		Entry("pre-release versions precede the normal version", "v2.0.0-rc.1", "v2.0.0", false),
+		Entry("equal pre-release versions", "v2.0.0-rc.1", "v2.0.0-rc.1", true),
+	)
+})
+
+var _ = Describe("func SunsetAfter()", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the time is zero", func() {
+		Expect(func() {
+			SunsetAfter(time.Time{})
+		}).To(PanicWith("sunset time must not be zero"))
+	})
+
+	DescribeTable(
+		"it compares the sunset time to the current time",
+		func(now time.Time, expectSunset bool) {
+			Bool("FERRITE_DEBUG", "<desc>").
+				Deprecated(SunsetAfter(time.Date(2030, 6, 30, 0, 0, 0, 0, time.UTC)))
+
+			os.Setenv("FERRITE_DEBUG", "true")
+			mode.DefaultConfig.Now = func() time.Time { return now }
+
+			Expect(initExits()).To(Equal(expectSunset))
+		},
+		Entry("before the sunset time", time.Date(2030, 6, 29, 0, 0, 0, 0, time.UTC), false),
+		Entry("after the sunset time", time.Date(2030, 7, 1, 0, 0, 0, 0, time.UTC), true),
+	)
+})
+
+// initExits calls Init() with the given options and returns true if it exits
+// with a non-zero status code.
+func initExits(options ...InitOption) bool {
+	exited := false
+
+	mode.DefaultConfig.Out = io.Discard
+	mode.DefaultConfig.Err = io.Discard
+	mode.DefaultConfig.Exit = func(code int) {
+		exited = code != 0
+	}
+
+	ferrite.Init(options...)
+
+	return exited
+}
+
+var _ = Describe("func WithAppVersion()", func() {
+	It("panics if the version is invalid", func() {
+		Expect(func() {
+			WithAppVersion("v1.0.0-")
+		}).To(PanicWith("invalid version (v1.0.0-): pre-release identifier must not be empty"))
+	})
+})
+
+func ExampleRemovedIn() {
+	defer example()()
+
+	ferrite.
+		Bool("FERRITE_DEBUG", "enable debug logging").
+		Deprecated(
+			ferrite.DeprecatedSince("v1.4.0"),
+			ferrite.RemovedIn("v2.0.0"),
+		)
+
+	os.Setenv("FERRITE_DEBUG", "true")
+	ferrite.Init(
+		ferrite.WithAppVersion("v1.5.2"),
+	)
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_DEBUG  enable debug logging  [ true | false ]  ⚠ deprecated variable set to true, to be removed in v2.0.0
+	//
+}
+
+func ExampleRemovedIn_removed() {
+	defer example()()
+
+	ferrite.
+		Bool("FERRITE_DEBUG", "enable debug logging").
+		Deprecated(
+			ferrite.DeprecatedSince("v1.4.0"),
+			ferrite.RemovedIn("v2.0.0"),
+		)
+
+	os.Setenv("FERRITE_DEBUG", "true")
+	ferrite.Init(
+		ferrite.WithAppVersion("v2.0.0"),
+	)
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_DEBUG  enable debug logging  [ true | false ]  ✗ deprecated variable set to true, removed in v2.0.0
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleSunsetAfter() {
+	defer example()()
+
+	ferrite.
+		Bool("FERRITE_DEBUG", "enable debug logging").
+		Deprecated(
+			ferrite.SunsetAfter(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+		)
+
+	os.Setenv("FERRITE_DEBUG", "true")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_DEBUG  enable debug logging  [ true | false ]  ✗ deprecated variable set to true, no longer supported since 2000-01-01
+	//
+	// <process exited with error code 1>
+}
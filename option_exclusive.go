@@ -0,0 +1,77 @@
+package ferrite
+
+import "github.com/dogmatiq/ferrite/internal/variable"
+
+// MutuallyExclusiveWith is an option for a variable set that prevents it from
+// being defined at the same time as the variables in another set, s.
+//
+// Defining variables from both sets causes each of the defined variables to be
+// reported as invalid. Default values are not considered to conflict with
+// explicitly defined values.
+func MutuallyExclusiveWith[T any](
+	s VariableSet[T],
+	_ ...MutuallyExclusiveWithOption,
+) interface {
+	RequiredOption
+	OptionalOption
+	DeprecatedOption
+} {
+	return option{
+		ApplyToSpec: func(b variable.SpecBuilder) {
+			for _, v := range s.variables() {
+				establishExclusion(b.Peek(), v.Spec(), false)
+			}
+		},
+	}
+}
+
+// MutuallyExclusiveWithOption changes the behavior of the
+// MutuallyExclusiveWith() option.
+type MutuallyExclusiveWithOption interface {
+	future()
+}
+
+// ExactlyOneOf requires that the variables from exactly one of the given
+// variable sets are defined.
+//
+// The sets are typically optional. Defining variables from more than one set
+// causes each of the defined variables to be reported as invalid. Leaving all
+// of the sets undefined causes each of their variables to be reported as
+// undefined, unless one of them has a default value.
+//
+// It must be called before [Init].
+func ExactlyOneOf(sets ...AnyVariableSet) {
+	if len(sets) < 2 {
+		panic("ExactlyOneOf() requires at least two variable sets")
+	}
+
+	for i, s := range sets {
+		for _, other := range sets[i+1:] {
+			for _, a := range s.variables() {
+				for _, b := range other.variables() {
+					establishExclusion(a.Spec(), b.Spec(), true)
+				}
+			}
+		}
+	}
+}
+
+// establishExclusion establishes a mutually exclusive relationship between two
+// variables, along with references between them for documentation purposes.
+func establishExclusion(a, b variable.Spec, exactlyOne bool) {
+	variable.EstablishRelationships(
+		variable.MutuallyExclusive{
+			Subject:               a,
+			MutuallyExclusiveWith: b,
+			ExactlyOne:            exactlyOne,
+		},
+		variable.RefersTo{
+			Subject:  a,
+			RefersTo: b,
+		},
+		variable.RefersTo{
+			Subject:  b,
+			RefersTo: a,
+		},
+	)
+}
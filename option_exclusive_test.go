@@ -0,0 +1,180 @@
+package ferrite_test
+
+import (
+	"os"
+
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func MutuallyExclusiveWith()", func() {
+	var (
+		token     Optional[string]
+		tokenFile Optional[FileName]
+	)
+
+	BeforeEach(func() {
+		token = String("FERRITE_AUTH_TOKEN", "<desc>").
+			Optional()
+
+		tokenFile = File("FERRITE_AUTH_TOKEN_FILE", "<desc>").
+			Optional(MutuallyExclusiveWith(token))
+
+		os.Setenv("FERRITE_AUTH_TOKEN", "<token>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("reports a conflict when both variables are defined", func() {
+		os.Setenv("FERRITE_AUTH_TOKEN_FILE", "/run/secrets/token")
+
+		Expect(func() {
+			token.Value()
+		}).To(PanicWith("value of FERRITE_AUTH_TOKEN ('<token>') is invalid: can not be used in combination with FERRITE_AUTH_TOKEN_FILE, define one or the other"))
+	})
+
+	It("does not report a conflict after the other variable is undefined", func() {
+		os.Setenv("FERRITE_AUTH_TOKEN_FILE", "/run/secrets/token")
+
+		Expect(func() {
+			token.Value()
+		}).To(Panic())
+
+		os.Unsetenv("FERRITE_AUTH_TOKEN_FILE")
+
+		v, ok := token.Value()
+		Expect(ok).To(BeTrue())
+		Expect(v).To(Equal("<token>"))
+
+		_, ok = tokenFile.Value()
+		Expect(ok).To(BeFalse())
+	})
+})
+
+var _ = Describe("func ExactlyOneOf()", func() {
+	var token Optional[string]
+
+	BeforeEach(func() {
+		token = String("FERRITE_AUTH_TOKEN", "<desc>").
+			Optional()
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	When("neither variable has a default value", func() {
+		var tokenFile Optional[FileName]
+
+		BeforeEach(func() {
+			tokenFile = File("FERRITE_AUTH_TOKEN_FILE", "<desc>").
+				Optional()
+
+			ExactlyOneOf(token, tokenFile)
+		})
+
+		It("reports an error when neither variable is defined", func() {
+			Expect(func() {
+				token.Value()
+			}).To(PanicWith("FERRITE_AUTH_TOKEN is undefined, define exactly one of FERRITE_AUTH_TOKEN and FERRITE_AUTH_TOKEN_FILE"))
+		})
+
+		It("does not report an error after the other variable is defined", func() {
+			Expect(func() {
+				token.Value()
+			}).To(Panic())
+
+			os.Setenv("FERRITE_AUTH_TOKEN_FILE", "/run/secrets/token")
+
+			_, ok := token.Value()
+			Expect(ok).To(BeFalse())
+
+			v, ok := tokenFile.Value()
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal(FileName("/run/secrets/token")))
+		})
+	})
+
+	When("the other variable has a derived default value", func() {
+		var (
+			secretsDir Optional[string]
+			tokenFile  Optional[FileName]
+		)
+
+		BeforeEach(func() {
+			secretsDir = String("FERRITE_SECRETS_DIR", "<desc>").
+				Optional()
+
+			tokenFile = WithDefaultFrom(
+				File("FERRITE_AUTH_TOKEN_FILE", "<desc>"),
+				secretsDir,
+				func(dir string) string {
+					return dir + "/token"
+				},
+			).Optional()
+
+			ExactlyOneOf(token, tokenFile)
+		})
+
+		It("does not report an error while the derived default value is available", func() {
+			os.Setenv("FERRITE_SECRETS_DIR", "/run/secrets")
+
+			_, ok := token.Value()
+			Expect(ok).To(BeFalse())
+
+			v, ok := tokenFile.Value()
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal(FileName("/run/secrets/token")))
+		})
+
+		It("reports an error after the derived default value becomes unavailable", func() {
+			os.Setenv("FERRITE_SECRETS_DIR", "/run/secrets")
+			token.Value()
+
+			os.Unsetenv("FERRITE_SECRETS_DIR")
+
+			Expect(func() {
+				token.Value()
+			}).To(PanicWith("FERRITE_AUTH_TOKEN is undefined, define exactly one of FERRITE_AUTH_TOKEN and FERRITE_AUTH_TOKEN_FILE"))
+		})
+	})
+
+	When("the other variable has a profile-specific default value", func() {
+		var tokenFile Optional[FileName]
+
+		BeforeEach(func() {
+			env := Enum("FERRITE_ENV", "<desc>").
+				WithMembers("development", "production").
+				WithDefault("development").
+				Required()
+
+			production := ProfileWhen(env, "production")
+
+			tokenFile = File("FERRITE_AUTH_TOKEN_FILE", "<desc>").
+				WithDefaultIn(production, "/run/secrets/token").
+				Optional()
+
+			ExactlyOneOf(token, tokenFile)
+		})
+
+		It("does not report an error while the profile is active", func() {
+			os.Setenv("FERRITE_ENV", "production")
+
+			_, ok := token.Value()
+			Expect(ok).To(BeFalse())
+
+			v, ok := tokenFile.Value()
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal(FileName("/run/secrets/token")))
+		})
+
+		It("reports an error while the profile is not active", func() {
+			Expect(func() {
+				token.Value()
+			}).To(PanicWith("FERRITE_AUTH_TOKEN is undefined, define exactly one of FERRITE_AUTH_TOKEN and FERRITE_AUTH_TOKEN_FILE"))
+		})
+	})
+})
@@ -4,13 +4,24 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/dogmatiq/ferrite/internal/maybe"
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
 
+// RelevanceCondition is a condition that determines whether a variable set is
+// relevant.
+//
+// Conditions are obtained from options such as [RelevantIf] and
+// [RelevantWhen], and may be combined using [RelevantIfAll] and
+// [RelevantIfAny].
+type RelevanceCondition interface {
+	relevanceCondition() condition
+}
+
 // RelevantIf is an option that enables a variable set only if the value
 // obtained from another set, s, is "truthy" (not the zero-value).
 //
+// If s produces a [TriStateValue], only [TriStateTrue] is considered truthy.
+//
 // An "irrelevant" variable set behaves as though its environment variables are
 // undefined, irrespective of the actual values of the variables and any default
 // values.
@@ -18,32 +29,63 @@ func RelevantIf[T any](s VariableSet[T], _ ...RelevantIfOption) interface {
 	RequiredOption
 	OptionalOption
 	DeprecatedOption
+	RelevanceCondition
 } {
-	return option{
-		ApplyToSpec: func(b variable.SpecBuilder) {
-			b.Precondition(
-				func() bool {
-					x, ok := s.native()
-					return ok && !reflect.ValueOf(x).IsZero()
-				},
-			)
-
-			for _, vari := range s.variables() {
-				dependsOn := variable.DependsOn{
-					Subject:   b.Peek(),
-					DependsOn: vari.Spec(),
-				}
+	return relevantIf(s, false)
+}
 
-				variable.EstablishRelationships(
-					variable.RefersTo{
-						Subject:  b.Peek(),
-						RefersTo: vari.Spec(),
-					},
-					dependsOn,
-				)
-			}
-		},
+// RelevantUnless is an option that enables a variable set only if the value
+// obtained from another set, s, is NOT "truthy", as defined by [RelevantIf].
+//
+// The variable set is also relevant if s is undefined.
+func RelevantUnless[T any](s VariableSet[T], _ ...RelevantUnlessOption) interface {
+	RequiredOption
+	OptionalOption
+	DeprecatedOption
+	RelevanceCondition
+} {
+	return relevantIf(s, true)
+}
+
+// relevantIf returns an option that enables a variable set only if the value
+// obtained from another set, s, is "truthy", or NOT "truthy" if negated is
+// true.
+func relevantIf[T any](s VariableSet[T], negated bool) relevance {
+	var zero T
+	if t, ok := any(zero).(truthy); ok {
+		v := t.truthyValue().(T)
+		return relevantWhen(
+			s,
+			[]T{v},
+			negated,
+			func(x T) bool {
+				return any(x) == any(v)
+			},
+		)
 	}
+
+	return newRelevance(
+		dependency(
+			s,
+			func() bool {
+				x, ok := s.native()
+				return negated != (ok && !reflect.ValueOf(x).IsZero())
+			},
+			func(int) variable.DependsOn {
+				return variable.DependsOn{
+					Negated: negated,
+				}
+			},
+		),
+	)
+}
+
+// truthy is an interface for types that have a single "truthy" value, as
+// opposed to treating any non-zero value as truthy.
+type truthy interface {
+	// truthyValue returns the truthy value. It must be of the same type as
+	// the value on which the method is called.
+	truthyValue() any
 }
 
 // RelevantWhen is an option that enables a variable set only if the value
@@ -56,49 +98,319 @@ func RelevantWhen[T comparable](s VariableSet[T], v T, _ ...RelevantWhenOption)
 	RequiredOption
 	OptionalOption
 	DeprecatedOption
+	RelevanceCondition
+} {
+	return relevantWhen(
+		s,
+		[]T{v},
+		false,
+		func(x T) bool {
+			return x == v
+		},
+	)
+}
+
+// RelevantWhenAny is an option that enables a variable set only if the value
+// obtained from another set, s, produces any one of the values in v.
+func RelevantWhenAny[T comparable](s VariableSet[T], v ...T) interface {
+	RequiredOption
+	OptionalOption
+	DeprecatedOption
+	RelevanceCondition
 } {
-	literals, err := s.literals(v)
-	if err != nil {
-		panic(fmt.Sprintf(
-			"cannot use value as precondition: %s",
-			err,
-		))
+	if len(v) == 0 {
+		panic("RelevantWhenAny() requires at least one value")
 	}
 
-	return option{
-		ApplyToSpec: func(b variable.SpecBuilder) {
-			b.Precondition(
-				func() bool {
-					x, ok := s.native()
-					return ok && x == v
-				},
-			)
-
-			for i, vari := range s.variables() {
-				dependsOn := variable.DependsOn{
-					Subject:   b.Peek(),
-					DependsOn: vari.Spec(),
-					Value:     maybe.Some(literals[i]),
+	return relevantWhen(
+		s,
+		v,
+		false,
+		func(x T) bool {
+			for _, v := range v {
+				if x == v {
+					return true
 				}
+			}
+			return false
+		},
+	)
+}
+
+// RelevantWhenFunc is an option that enables a variable set only if the value
+// obtained from another set, s, satisfies the predicate fn.
+//
+// desc is a human-readable description of the predicate, used in generated
+// documentation. It must complete a sentence that begins with the name of the
+// variable, such as "is greater than 10".
+func RelevantWhenFunc[T any](
+	s VariableSet[T],
+	fn func(T) bool,
+	desc string,
+	_ ...RelevantWhenFuncOption,
+) interface {
+	RequiredOption
+	OptionalOption
+	DeprecatedOption
+	RelevanceCondition
+} {
+	if desc == "" {
+		panic("predicate description must not be empty")
+	}
+
+	return newRelevance(
+		dependency(
+			s,
+			func() bool {
+				x, ok := s.native()
+				return ok && fn(x)
+			},
+			func(int) variable.DependsOn {
+				return variable.DependsOn{
+					Description: desc,
+				}
+			},
+		),
+	)
+}
+
+// relevantWhen returns an option that enables a variable set only if the
+// value obtained from another set, s, is equal to any one of the values in v,
+// as determined by eq, or none of them if negated is true.
+func relevantWhen[T any](
+	s VariableSet[T],
+	v []T,
+	negated bool,
+	eq func(T) bool,
+) relevance {
+	literals := make([][]variable.Literal, len(v))
+	for i, x := range v {
+		lits, err := s.literals(x)
+		if err != nil {
+			panic(fmt.Sprintf(
+				"cannot use value as precondition: %s",
+				err,
+			))
+		}
+		literals[i] = lits
+	}
+
+	return newRelevance(
+		dependency(
+			s,
+			func() bool {
+				x, ok := s.native()
+				return negated != (ok && eq(x))
+			},
+			func(i int) variable.DependsOn {
+				rel := variable.DependsOn{
+					Negated: negated,
+				}
+				for _, lits := range literals {
+					rel.Values = append(rel.Values, lits[i])
+				}
+				return rel
+			},
+		),
+	)
+}
+
+// RelevantIfAll is an option that enables a variable set only if all of the
+// given conditions are met.
+//
+// It is equivalent to passing each of the conditions as a separate option, but
+// may be nested within [RelevantIfAny].
+func RelevantIfAll(conditions ...RelevanceCondition) interface {
+	RequiredOption
+	OptionalOption
+	DeprecatedOption
+	RelevanceCondition
+} {
+	if len(conditions) == 0 {
+		panic("RelevantIfAll() requires at least one condition")
+	}
+
+	var c condition
+	var checks []func() bool
+
+	for _, rc := range conditions {
+		x := rc.relevanceCondition()
+		checks = append(checks, x.check)
+		c.clauses = append(c.clauses, x.clauses...)
+	}
+
+	c.check = func() bool {
+		for _, fn := range checks {
+			if !fn() {
+				return false
+			}
+		}
+		return true
+	}
+
+	return newRelevance(c)
+}
+
+// RelevantIfAny is an option that enables a variable set if any one of the
+// given conditions is met.
+func RelevantIfAny(conditions ...RelevanceCondition) interface {
+	RequiredOption
+	OptionalOption
+	DeprecatedOption
+	RelevanceCondition
+} {
+	if len(conditions) == 0 {
+		panic("RelevantIfAny() requires at least one condition")
+	}
+
+	var alternatives [][]variable.DependsOn
+	var checks []func() bool
+
+	for _, rc := range conditions {
+		x := rc.relevanceCondition()
+		checks = append(checks, x.check)
 
-				variable.EstablishRelationships(
-					variable.RefersTo{
-						Subject:  b.Peek(),
-						RefersTo: vari.Spec(),
-					},
-					dependsOn,
-				)
+		if len(x.clauses) == 1 {
+			// The condition is itself a set of alternatives (or a single
+			// alternative), which are flattened into this set.
+			alternatives = append(alternatives, x.clauses[0]...)
+			continue
+		}
+
+		// Otherwise, the condition can only be expressed as a single
+		// alternative if each of its clauses has a single alternative.
+		var alt []variable.DependsOn
+		for _, clause := range x.clauses {
+			if len(clause) != 1 {
+				panic("cannot use condition in RelevantIfAny(), it is nested too deeply")
 			}
+			alt = append(alt, clause[0]...)
+		}
+		alternatives = append(alternatives, alt)
+	}
+
+	return newRelevance(
+		condition{
+			func() bool {
+				for _, fn := range checks {
+					if fn() {
+						return true
+					}
+				}
+				return false
+			},
+			[][][]variable.DependsOn{alternatives},
 		},
+	)
+}
+
+// condition is a predicate that determines whether a variable set is relevant.
+type condition struct {
+	// check returns true if the condition is met.
+	check func() bool
+
+	// clauses describes the condition for documentation purposes.
+	//
+	// The condition is met if all of the clauses are met. A clause is met if
+	// any one of its alternatives is met, and an alternative is met if all of
+	// its dependencies are met.
+	clauses [][][]variable.DependsOn
+}
+
+// dependency returns a condition that depends on each of the variables in s.
+//
+// rel returns the relationship for the i'th variable in s. Its Subject and
+// DependsOn fields are populated automatically.
+func dependency[T any](
+	s VariableSet[T],
+	check func() bool,
+	rel func(i int) variable.DependsOn,
+) condition {
+	var deps []variable.DependsOn
+
+	for i, v := range s.variables() {
+		d := rel(i)
+		d.DependsOn = v.Spec()
+		deps = append(deps, d)
+	}
+
+	return condition{
+		check,
+		[][][]variable.DependsOn{{deps}},
 	}
 }
 
+// relevance is an option that enables a variable set only if a condition is
+// met.
+type relevance struct {
+	option
+	cond condition
+}
+
+func newRelevance(c condition) relevance {
+	return relevance{
+		option{
+			ApplyToSpec: func(b variable.SpecBuilder) {
+				b.Precondition(c.check)
+
+				group := 0
+				for _, rel := range variable.Relationships[variable.DependsOn](b.Peek()) {
+					if rel.Group > group {
+						group = rel.Group
+					}
+				}
+
+				for _, clause := range c.clauses {
+					if len(clause) > 1 {
+						group++
+					}
+
+					for i, alt := range clause {
+						for _, rel := range alt {
+							rel.Subject = b.Peek()
+
+							if len(clause) > 1 {
+								rel.Group = group
+								rel.Alternative = i
+							}
+
+							variable.EstablishRelationships(
+								variable.RefersTo{
+									Subject:  b.Peek(),
+									RefersTo: rel.DependsOn,
+								},
+								rel,
+							)
+						}
+					}
+				}
+			},
+		},
+		c,
+	}
+}
+
+func (r relevance) relevanceCondition() condition {
+	return r.cond
+}
+
 // RelevantIfOption changes the behavior of the [RelevantIf] options.
 type RelevantIfOption interface {
 	future()
 }
 
+// RelevantUnlessOption changes the behavior of the [RelevantUnless] option.
+type RelevantUnlessOption interface {
+	future()
+}
+
 // RelevantWhenOption changes the behavior of the [RelevantWhen] option.
 type RelevantWhenOption interface {
 	future()
 }
+
+// RelevantWhenFuncOption changes the behavior of the [RelevantWhenFunc]
+// option.
+type RelevantWhenFuncOption interface {
+	future()
+}
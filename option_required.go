@@ -0,0 +1,121 @@
+package ferrite
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// RequiredIf is an option that makes an optional variable set required if the
+// value obtained from another set, s, is "truthy" (not the zero-value).
+//
+// If s produces a [TriStateValue], only [TriStateTrue] is considered truthy.
+//
+// Unlike [RelevantIf], the variable set's value is still used when the
+// condition is not met.
+func RequiredIf[T any](s VariableSet[T], _ ...RequiredIfOption) OptionalOption {
+	var zero T
+	if t, ok := any(zero).(truthy); ok {
+		v := t.truthyValue().(T)
+		return requiredWhen(
+			s,
+			v,
+			func(x T) bool {
+				return any(x) == any(v)
+			},
+		)
+	}
+
+	return option{
+		ApplyToSpecInOptionalSet: func(b variable.SpecBuilder) {
+			b.Requirement(
+				func() bool {
+					x, ok := s.native()
+					return ok && !reflect.ValueOf(x).IsZero()
+				},
+			)
+
+			for _, vari := range s.variables() {
+				variable.EstablishRelationships(
+					variable.RefersTo{
+						Subject:  b.Peek(),
+						RefersTo: vari.Spec(),
+					},
+					variable.DependsOn{
+						Subject:   b.Peek(),
+						DependsOn: vari.Spec(),
+						Required:  true,
+					},
+				)
+			}
+		},
+	}
+}
+
+// RequiredWhen is an option that makes an optional variable set required if
+// the value obtained from another set, s, is v.
+//
+// Unlike [RelevantWhen], the variable set's value is still used when the
+// condition is not met.
+func RequiredWhen[T comparable](s VariableSet[T], v T, _ ...RequiredWhenOption) OptionalOption {
+	return requiredWhen(
+		s,
+		v,
+		func(x T) bool {
+			return x == v
+		},
+	)
+}
+
+// requiredWhen returns an option that makes a variable set required if the
+// value obtained from another set, s, is equal to v, as determined by eq.
+func requiredWhen[T any](
+	s VariableSet[T],
+	v T,
+	eq func(T) bool,
+) option {
+	literals, err := s.literals(v)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"cannot use value as requirement: %s",
+			err,
+		))
+	}
+
+	return option{
+		ApplyToSpecInOptionalSet: func(b variable.SpecBuilder) {
+			b.Requirement(
+				func() bool {
+					x, ok := s.native()
+					return ok && eq(x)
+				},
+			)
+
+			for i, vari := range s.variables() {
+				variable.EstablishRelationships(
+					variable.RefersTo{
+						Subject:  b.Peek(),
+						RefersTo: vari.Spec(),
+					},
+					variable.DependsOn{
+						Subject:   b.Peek(),
+						DependsOn: vari.Spec(),
+						Values:    []variable.Literal{literals[i]},
+						Required:  true,
+					},
+				)
+			}
+		},
+	}
+}
+
+// RequiredIfOption changes the behavior of the [RequiredIf] option.
+type RequiredIfOption interface {
+	future()
+}
+
+// RequiredWhenOption changes the behavior of the [RequiredWhen] option.
+type RequiredWhenOption interface {
+	future()
+}
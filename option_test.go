@@ -107,6 +107,25 @@ func ExampleRelevantIf_whenNotRelevantButInvalid() {
 	//  ❯ FERRITE_WIDGET_SPEED    set the speed of the widget    <uint>          ✗ set to -100, expected integer
 }
 
+func ExampleRelevantIf_triState() {
+	defer example()()
+
+	widgetEnabled := ferrite.
+		TriState("FERRITE_WIDGET_ENABLED", "enable the widget").
+		Required()
+
+	ferrite.
+		Unsigned[uint]("FERRITE_WIDGET_SPEED", "set the speed of the widget").
+		Required(ferrite.RelevantIf(widgetEnabled))
+
+	// FERRITE_WIDGET_SPEED is only relevant when FERRITE_WIDGET_ENABLED is
+	// explicitly "true", so it can be left undefined when it is "auto".
+	os.Setenv("FERRITE_WIDGET_ENABLED", "auto")
+	ferrite.Init()
+
+	// Output:
+}
+
 func ExampleRelevantWhen_whenRelevant() {
 	defer example()()
 
@@ -177,3 +196,356 @@ func ExampleRelevantWhen_whenNotRelevantButInvalid() {
 	//    FERRITE_WIDGET_MODE   set the widget mode            stationary | moving    ✓ set to stationary
 	//  ❯ FERRITE_WIDGET_SPEED  set the speed of the widget    <uint>                 ✗ set to -100, expected integer
 }
+
+func ExampleRelevantUnless() {
+	defer example()()
+
+	quiet := ferrite.
+		Bool("FERRITE_QUIET", "suppress all output").
+		Required()
+
+	verbose := ferrite.
+		Bool("FERRITE_VERBOSE", "enable verbose logging").
+		Optional(ferrite.RelevantUnless(quiet))
+
+	os.Setenv("FERRITE_QUIET", "true")
+	os.Setenv("FERRITE_VERBOSE", "true")
+	ferrite.Init()
+
+	if x, ok := verbose.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is not relevant")
+	}
+
+	// Output:
+	// value is not relevant
+}
+
+func ExampleRelevantWhenAny() {
+	defer example()()
+
+	widgetMode := ferrite.
+		Enum("FERRITE_WIDGET_MODE", "set the widget mode").
+		WithMembers("stationary", "rolling", "flying").
+		Required()
+
+	widgetSpeed := ferrite.
+		Unsigned[uint]("FERRITE_WIDGET_SPEED", "set the speed of the widget").
+		Optional(ferrite.RelevantWhenAny(widgetMode, "rolling", "flying"))
+
+	os.Setenv("FERRITE_WIDGET_MODE", "flying")
+	os.Setenv("FERRITE_WIDGET_SPEED", "100")
+	ferrite.Init()
+
+	if x, ok := widgetSpeed.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is not relevant")
+	}
+
+	// Output:
+	// value is 100
+}
+
+func ExampleRelevantWhenFunc() {
+	defer example()()
+
+	workers := ferrite.
+		Unsigned[uint]("FERRITE_WORKERS", "number of worker processes").
+		Required()
+
+	coordinator := ferrite.
+		String("FERRITE_COORDINATOR", "address of the worker coordinator").
+		Optional(
+			ferrite.RelevantWhenFunc(
+				workers,
+				func(n uint) bool { return n > 1 },
+				"is greater than 1",
+			),
+		)
+
+	os.Setenv("FERRITE_WORKERS", "1")
+	os.Setenv("FERRITE_COORDINATOR", "coordinator.example.org")
+	ferrite.Init()
+
+	if x, ok := coordinator.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is not relevant")
+	}
+
+	// Output:
+	// value is not relevant
+}
+
+func ExampleRelevantIfAny() {
+	defer example()()
+
+	debug := ferrite.
+		Bool("FERRITE_DEBUG", "enable debugging features").
+		Required()
+
+	widgetMode := ferrite.
+		Enum("FERRITE_WIDGET_MODE", "set the widget mode").
+		WithMembers("stationary", "rolling", "flying").
+		Required()
+
+	widgetTrace := ferrite.
+		Bool("FERRITE_WIDGET_TRACE", "trace the widget's movements").
+		Optional(
+			ferrite.RelevantIfAny(
+				ferrite.RelevantIf(debug),
+				ferrite.RelevantWhen(widgetMode, "flying"),
+			),
+		)
+
+	os.Setenv("FERRITE_DEBUG", "false")
+	os.Setenv("FERRITE_WIDGET_MODE", "flying")
+	os.Setenv("FERRITE_WIDGET_TRACE", "true")
+	ferrite.Init()
+
+	if x, ok := widgetTrace.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is not relevant")
+	}
+
+	// Output:
+	// value is true
+}
+
+func ExampleRelevantIfAll() {
+	defer example()()
+
+	debug := ferrite.
+		Bool("FERRITE_DEBUG", "enable debugging features").
+		Required()
+
+	widgetMode := ferrite.
+		Enum("FERRITE_WIDGET_MODE", "set the widget mode").
+		WithMembers("stationary", "rolling", "flying").
+		Required()
+
+	widgetTrace := ferrite.
+		Bool("FERRITE_WIDGET_TRACE", "trace the widget's movements").
+		Optional(
+			ferrite.RelevantIfAny(
+				ferrite.RelevantWhen(widgetMode, "stationary"),
+				ferrite.RelevantIfAll(
+					ferrite.RelevantIf(debug),
+					ferrite.RelevantWhen(widgetMode, "flying"),
+				),
+			),
+		)
+
+	os.Setenv("FERRITE_DEBUG", "false")
+	os.Setenv("FERRITE_WIDGET_MODE", "flying")
+	os.Setenv("FERRITE_WIDGET_TRACE", "true")
+	ferrite.Init()
+
+	if x, ok := widgetTrace.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is not relevant")
+	}
+
+	// Output:
+	// value is not relevant
+}
+
+func ExampleRequiredIf_whenRequired() {
+	defer example()()
+
+	auth := ferrite.
+		Bool("FERRITE_SMTP_AUTH", "enable SMTP authentication").
+		Required()
+
+	ferrite.
+		String("FERRITE_SMTP_PASSWORD", "SMTP password").
+		Optional(ferrite.RequiredIf(auth))
+
+	os.Setenv("FERRITE_SMTP_AUTH", "true")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_SMTP_AUTH      enable SMTP authentication    true | false    ✓ set to true
+	//  ❯ FERRITE_SMTP_PASSWORD  SMTP password               [ <string> ]      ✗ undefined, required when FERRITE_SMTP_AUTH is not false
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleRequiredIf_whenNotRequired() {
+	defer example()()
+
+	auth := ferrite.
+		Bool("FERRITE_SMTP_AUTH", "enable SMTP authentication").
+		Required()
+
+	password := ferrite.
+		String("FERRITE_SMTP_PASSWORD", "SMTP password").
+		Optional(ferrite.RequiredIf(auth))
+
+	os.Setenv("FERRITE_SMTP_AUTH", "false")
+	ferrite.Init()
+
+	if x, ok := password.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleRequiredWhen_whenRequired() {
+	defer example()()
+
+	backend := ferrite.
+		Enum("FERRITE_EMAIL_BACKEND", "the email delivery mechanism").
+		WithMembers("smtp", "sendmail").
+		Required()
+
+	ferrite.
+		String("FERRITE_SMTP_PASSWORD", "SMTP password").
+		Optional(ferrite.RequiredWhen(backend, "smtp"))
+
+	os.Setenv("FERRITE_EMAIL_BACKEND", "smtp")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_EMAIL_BACKEND  the email delivery mechanism    smtp | sendmail    ✓ set to smtp
+	//  ❯ FERRITE_SMTP_PASSWORD  SMTP password                 [ <string> ]         ✗ undefined, required when FERRITE_EMAIL_BACKEND is smtp
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleRequiredWhen_whenNotRequired() {
+	defer example()()
+
+	backend := ferrite.
+		Enum("FERRITE_EMAIL_BACKEND", "the email delivery mechanism").
+		WithMembers("smtp", "sendmail").
+		Required()
+
+	password := ferrite.
+		String("FERRITE_SMTP_PASSWORD", "SMTP password").
+		Optional(ferrite.RequiredWhen(backend, "smtp"))
+
+	// FERRITE_SMTP_PASSWORD is not required, but it is still used if defined.
+	os.Setenv("FERRITE_EMAIL_BACKEND", "sendmail")
+	os.Setenv("FERRITE_SMTP_PASSWORD", "hunter2")
+	ferrite.Init()
+
+	if x, ok := password.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is hunter2
+}
+
+func ExampleMutuallyExclusiveWith() {
+	defer example()()
+
+	token := ferrite.
+		String("FERRITE_AUTH_TOKEN", "authentication token").
+		Optional()
+
+	ferrite.
+		File("FERRITE_AUTH_TOKEN_FILE", "file containing the authentication token").
+		Optional(ferrite.MutuallyExclusiveWith(token))
+
+	os.Setenv("FERRITE_AUTH_TOKEN", "<token>")
+	ferrite.Init()
+
+	if x, ok := token.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is <token>
+}
+
+func ExampleMutuallyExclusiveWith_conflict() {
+	defer example()()
+
+	token := ferrite.
+		String("FERRITE_AUTH_TOKEN", "authentication token").
+		Optional()
+
+	ferrite.
+		File("FERRITE_AUTH_TOKEN_FILE", "file containing the authentication token").
+		Optional(ferrite.MutuallyExclusiveWith(token))
+
+	os.Setenv("FERRITE_AUTH_TOKEN", "<token>")
+	os.Setenv("FERRITE_AUTH_TOKEN_FILE", "/run/secrets/token")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_AUTH_TOKEN       authentication token                      [ <string> ]  ✗ set to '<token>', can not be used in combination with FERRITE_AUTH_TOKEN_FILE, define one or the other
+	//  ❯ FERRITE_AUTH_TOKEN_FILE  file containing the authentication token  [ <string> ]  ✗ set to /run/secrets/token, can not be used in combination with FERRITE_AUTH_TOKEN, define one or the other
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleExactlyOneOf() {
+	defer example()()
+
+	token := ferrite.
+		String("FERRITE_AUTH_TOKEN", "authentication token").
+		Optional()
+
+	tokenFile := ferrite.
+		File("FERRITE_AUTH_TOKEN_FILE", "file containing the authentication token").
+		Optional()
+
+	ferrite.ExactlyOneOf(token, tokenFile)
+
+	os.Setenv("FERRITE_AUTH_TOKEN_FILE", "/run/secrets/token")
+	ferrite.Init()
+
+	if x, ok := tokenFile.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is /run/secrets/token
+}
+
+func ExampleExactlyOneOf_undefined() {
+	defer example()()
+
+	token := ferrite.
+		String("FERRITE_AUTH_TOKEN", "authentication token").
+		Optional()
+
+	tokenFile := ferrite.
+		File("FERRITE_AUTH_TOKEN_FILE", "file containing the authentication token").
+		Optional()
+
+	ferrite.ExactlyOneOf(token, tokenFile)
+
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_AUTH_TOKEN       authentication token                      [ <string> ]  ✗ undefined, define exactly one of FERRITE_AUTH_TOKEN and FERRITE_AUTH_TOKEN_FILE
+	//  ❯ FERRITE_AUTH_TOKEN_FILE  file containing the authentication token  [ <string> ]  ✗ undefined, define exactly one of FERRITE_AUTH_TOKEN_FILE and FERRITE_AUTH_TOKEN
+	//
+	// <process exited with error code 1>
+}
@@ -0,0 +1,106 @@
+package ferrite
+
+import (
+	"fmt"
+
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// Profile is a named configuration profile, such as "development" or
+// "production", that is active when another variable set has a specific value.
+//
+// Profiles are used to provide different default values in different
+// environments. See the WithDefaultIn() method on the builder types.
+type Profile struct {
+	profile *variable.Profile
+}
+
+// profileDefault is a profile-specific default value that is added to a
+// specification when the builder is finalized, for builders that can only
+// parse their default values once all of the builder's options are known.
+type profileDefault[T any] struct {
+	profile *Profile
+	value   T
+}
+
+// ProfileWhen returns a profile that is active when the value obtained from
+// s is v.
+//
+// s must contain a single variable, such as an APP_ENV variable built using
+// [Enum]. The literal representation of v is used as the profile's name.
+func ProfileWhen[T comparable](s VariableSet[T], v T) *Profile {
+	vars := s.variables()
+	if len(vars) != 1 {
+		panic("ProfileWhen() requires a variable set with exactly one variable")
+	}
+
+	lits, err := s.literals(v)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"cannot use value as profile: %s",
+			err,
+		))
+	}
+
+	return &Profile{
+		variable.NewProfile(
+			vars[0].Spec(),
+			lits[0],
+			func() bool {
+				x, ok := s.native()
+				return ok && x == v
+			},
+		),
+	}
+}
+
+// Name returns the name of the profile.
+func (p *Profile) Name() string {
+	return p.profile.Value.String
+}
+
+// IsActive returns true if the profile is currently active.
+func (p *Profile) IsActive() bool {
+	return p.profile.IsActive()
+}
+
+// RequiredIn is an option that makes an optional variable set required when
+// any one of the given profiles is active.
+//
+// Unlike [RelevantWhen], the variable set's value is still used in other
+// profiles.
+func RequiredIn(profiles ...*Profile) OptionalOption {
+	if len(profiles) == 0 {
+		panic("RequiredIn() requires at least one profile")
+	}
+
+	return option{
+		ApplyToSpecInOptionalSet: func(b variable.SpecBuilder) {
+			b.Requirement(
+				func() bool {
+					for _, p := range profiles {
+						if p.IsActive() {
+							return true
+						}
+					}
+					return false
+				},
+			)
+
+			for _, p := range profiles {
+				variable.EstablishRelationships(
+					variable.RefersTo{
+						Subject:  b.Peek(),
+						RefersTo: p.profile.Selector,
+					},
+					variable.DependsOn{
+						Subject:   b.Peek(),
+						DependsOn: p.profile.Selector,
+						Values:    []variable.Literal{p.profile.Value},
+						Required:  true,
+					},
+				)
+			}
+		},
+	}
+}
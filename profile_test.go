@@ -0,0 +1,202 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Profile", func() {
+	var (
+		env         Required[string]
+		development *Profile
+		production  *Profile
+	)
+
+	BeforeEach(func() {
+		env = Enum("FERRITE_ENV", "<desc>").
+			WithMembers("development", "production").
+			WithDefault("development").
+			Required()
+
+		development = ProfileWhen(env, "development")
+		production = ProfileWhen(env, "production")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	Describe("func ProfileWhen()", func() {
+		It("panics if the value is not valid for the selector", func() {
+			Expect(func() {
+				ProfileWhen(env, "<invalid>")
+			}).To(PanicWith("cannot use value as profile: expected either development or production"))
+		})
+	})
+
+	Describe("func Name()", func() {
+		It("returns the literal value that selects the profile", func() {
+			Expect(production.Name()).To(Equal("production"))
+		})
+	})
+
+	Describe("func IsActive()", func() {
+		It("returns true if the selector has the profile's value", func() {
+			Expect(development.IsActive()).To(BeTrue())
+			Expect(production.IsActive()).To(BeFalse())
+
+			os.Setenv("FERRITE_ENV", "production")
+
+			Expect(development.IsActive()).To(BeFalse())
+			Expect(production.IsActive()).To(BeTrue())
+		})
+	})
+
+	When("a variable has a profile-specific default value", func() {
+		var port Required[uint16]
+
+		BeforeEach(func() {
+			port = Unsigned[uint16]("FERRITE_PORT", "<desc>").
+				WithDefault(8080).
+				WithDefaultIn(production, 80).
+				Required()
+		})
+
+		It("uses the profile's default value when the profile is active", func() {
+			os.Setenv("FERRITE_ENV", "production")
+			Expect(port.Value()).To(BeNumerically("==", 80))
+		})
+
+		It("uses the regular default value when the profile is not active", func() {
+			Expect(port.Value()).To(BeNumerically("==", 8080))
+		})
+
+		It("uses the value from the environment when it is defined", func() {
+			os.Setenv("FERRITE_ENV", "production")
+			os.Setenv("FERRITE_PORT", "9000")
+			Expect(port.Value()).To(BeNumerically("==", 9000))
+		})
+
+		It("panics if the profile's default value does not satisfy the constraints", func() {
+			Expect(func() {
+				Unsigned[uint16]("FERRITE_PORT_LIMITED", "<desc>").
+					WithMinimum(1024).
+					WithDefaultIn(production, 80).
+					Required()
+			}).To(PanicWith(`specification for FERRITE_PORT_LIMITED is invalid: default value for the production profile: too low, expected 1024 or greater`))
+		})
+	})
+
+	When("a builder parses its default values when it is finalized", func() {
+		BeforeEach(func() {
+			os.Setenv("FERRITE_ENV", "production")
+		})
+
+		It("uses the profile's default members of an enum set", func() {
+			v := EnumSet("FERRITE_ENUMSET", "<desc>").
+				WithDefaultIn(production, "baz", "foo").
+				WithMembers("foo", "bar", "baz").
+				Required()
+
+			Expect(v.Value().Members()).To(Equal([]string{"foo", "baz"}))
+		})
+
+		It("parses the profile's default value using the final options", func() {
+			v := Regexp("FERRITE_REGEXP", "<desc>").
+				WithDefaultIn(production, "[a-z]+").
+				WithAnchoring().
+				Required()
+
+			Expect(v.Value().String()).To(Equal("^(?:[a-z]+)$"))
+		})
+	})
+
+	It("uses the profile's default values for a kubernetes service", func() {
+		os.Setenv("FERRITE_ENV", "production")
+
+		v := KubernetesService("ferrite-svc").
+			WithDefault("default.example.org", "8080").
+			WithDefaultIn(production, "host.example.org", "80").
+			Required()
+
+		Expect(v.Value()).To(Equal(
+			KubernetesAddress{
+				Host: "host.example.org",
+				Port: "80",
+			},
+		))
+	})
+
+	Describe("func RequiredIn()", func() {
+		It("panics if there are no profiles", func() {
+			Expect(func() {
+				RequiredIn()
+			}).To(PanicWith("RequiredIn() requires at least one profile"))
+		})
+	})
+})
+
+func ExampleProfileWhen() {
+	defer example()()
+
+	env := ferrite.
+		Enum("FERRITE_APP_ENV", "the environment in which the application is running").
+		WithMembers("development", "production").
+		WithDefault("development").
+		Required()
+
+	production := ferrite.ProfileWhen(env, "production")
+
+	port := ferrite.
+		NetworkPort("FERRITE_HTTP_PORT", "the port for the HTTP server").
+		WithDefault("8080").
+		WithDefaultIn(production, "80").
+		Required()
+
+	os.Setenv("FERRITE_APP_ENV", "production")
+	ferrite.Init()
+
+	fmt.Println("port is", port.Value())
+
+	// Output:
+	// port is 80
+}
+
+func ExampleRequiredIn() {
+	defer example()()
+
+	env := ferrite.
+		Enum("FERRITE_APP_ENV", "the environment in which the application is running").
+		WithMembers("development", "production").
+		WithDefault("development").
+		Required()
+
+	production := ferrite.ProfileWhen(env, "production")
+
+	ferrite.
+		NetworkPort("FERRITE_HTTP_PORT", "the port for the HTTP server").
+		WithDefault("8080").
+		WithDefaultIn(production, "80").
+		Required()
+
+	ferrite.
+		String("FERRITE_SENTRY_DSN", "the DSN used to report errors").
+		Optional(ferrite.RequiredIn(production))
+
+	os.Setenv("FERRITE_APP_ENV", "production")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_APP_ENV     the environment in which the application is running  [ development | production ] = development  ✓ set to production, production profile is active
+	//    FERRITE_HTTP_PORT   the port for the HTTP server                         [ <string> ] = 80                           ✓ using default value for the production profile
+	//  ❯ FERRITE_SENTRY_DSN  the DSN used to report errors                        [ <string> ]                                ✗ undefined, required when FERRITE_APP_ENV is production
+	//
+	// <process exited with error code 1>
+}
@@ -1,6 +1,9 @@
 package ferrite
 
 import (
+	"fmt"
+
+	"github.com/dogmatiq/ferrite/internal/inflect"
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
 
@@ -18,7 +21,57 @@ type VariableSet[T any] interface {
 	literals(T) ([]variable.Literal, error)
 }
 
+// AnyVariableSet is a variable set that produces a value of any type.
+//
+// It is accepted by functions that operate on several variable sets of
+// differing types, such as [Constrain] and [ExactlyOneOf]. Every
+// [VariableSet] is also an AnyVariableSet.
+type AnyVariableSet interface {
+	variables() []variable.Any
+}
+
 // variableSetConfig encapsulates configuration common to all variable sets.
 type variableSetConfig struct {
 	Registries []*variable.Registry
 }
+
+// checkDefinedTogether returns an error if some, but not all, of the given
+// variables are defined.
+func checkDefinedTogether(vars ...variable.Any) error {
+	var defined, undefined []string
+
+	for _, v := range vars {
+		if v.Availability() == variable.AvailabilityOK {
+			defined = append(defined, v.Spec().Name())
+		} else {
+			undefined = append(undefined, v.Spec().Name())
+		}
+	}
+
+	if len(defined) == 0 || len(undefined) == 0 {
+		return nil
+	}
+
+	if len(vars) == 2 {
+		return fmt.Errorf(
+			"%s is defined but %s is not, define both or neither",
+			defined[0],
+			undefined[0],
+		)
+	}
+
+	verb := func(names []string) string {
+		if len(names) == 1 {
+			return "is"
+		}
+		return "are"
+	}
+
+	return fmt.Errorf(
+		"%s %s defined but %s %s not, define all or none",
+		inflect.AndList(defined),
+		verb(defined),
+		inflect.AndList(undefined),
+		verb(undefined),
+	)
+}